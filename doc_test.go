@@ -2,6 +2,7 @@ package xsel_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
 	"github.com/ChrisTrenkamp/xsel"
@@ -42,6 +43,21 @@ func ExampleExecAsString() {
 	// Output: This is the first node.
 }
 
+func ExampleIterate() {
+	xml := `<root><a>1</a><a>2</a><a>3</a></root>`
+
+	xpath := xsel.MustCompile(`//a`)
+	cursor, _ := xsel.ReadXml(bytes.NewBufferString(xml))
+
+	xsel.Iterate(cursor, xpath, func(node xsel.Cursor) bool {
+		fmt.Println(xsel.GetCursorString(node))
+		return xsel.GetCursorString(node) != "2"
+	})
+
+	// Output: 1
+	// 2
+}
+
 func ExampleExecAsNumber() {
 	xml := `
 <root>
@@ -81,6 +97,28 @@ func ExampleExecAsNodeset() {
 	// This is the second node.
 }
 
+func ExampleExecAsSequence() {
+	xml := `
+<order>
+	<line price="2.50"/>
+	<line price="4"/>
+</order>
+`
+
+	xpath := xsel.MustBuildExpr(`(for $p in /order/line/@price return $p * 2, 'lines', count(/order/line))`)
+	cursor, _ := xsel.ReadXml(bytes.NewBufferString(xml))
+	result, _ := xsel.ExecAsSequence(cursor, &xpath)
+
+	for _, i := range result {
+		fmt.Println(i)
+	}
+
+	// Output: 5
+	// 8
+	// lines
+	// 2
+}
+
 func ExampleExecAsNodeset_subqueries() {
 	xml := `
 <root>
@@ -104,6 +142,74 @@ func ExampleExecAsNodeset_subqueries() {
 	// 1: A c element.
 }
 
+func ExampleCompile() {
+	xml := `
+<root xmlns="http://some.namespace.com">
+	<a>This is the first node.</a>
+	<a>This is the second node.</a>
+</root>
+`
+
+	xpath := xsel.MustCompile(`/ns:root/ns:a[2]`, xsel.WithNS("ns", "http://some.namespace.com"))
+	cursor, _ := xsel.ReadXml(bytes.NewBufferString(xml))
+	result, _ := xsel.ExecExpr(cursor, xpath)
+
+	fmt.Println(result)
+	// Output: This is the second node.
+}
+
+func ExamplePrepare() {
+	xml := `
+<root>
+	<order id="1">First order</order>
+	<order id="2">Second order</order>
+</root>
+`
+
+	query := xsel.MustPrepare(`/root/order[@id = $id]`, xsel.WithVariable("id", xsel.String("1")))
+	cursor, _ := xsel.ReadXml(bytes.NewBufferString(xml))
+
+	first, _ := query.Run(cursor)
+	second, _ := query.Run(cursor, xsel.WithVariable("id", xsel.String("2")))
+
+	fmt.Println(first)
+	fmt.Println(second)
+	// Output: First order
+	// Second order
+}
+
+func ExampleExplain() {
+	xpath := xsel.MustCompile(`/root/a[2]/name()`)
+
+	fmt.Print(xsel.Explain(xpath))
+	// Output:
+	// Path
+	//   Root
+	//   Step child::root
+	//   Step child::a
+	//     Predicate
+	//       Number 2
+	//   FunctionCall name/0
+	// Extensions:
+	//   mid-path function call: name()
+}
+
+func ExampleSyntaxError() {
+	_, err := xsel.BuildExpr("/root/a[@id = 'x']]")
+
+	syntaxErr := &xsel.SyntaxError{}
+
+	if errors.As(err, &syntaxErr) {
+		fmt.Println(syntaxErr.Line, syntaxErr.Column, syntaxErr.Found)
+		fmt.Println(syntaxErr.Snippet())
+	}
+
+	// Output:
+	// 1 19 ]
+	// /root/a[@id = 'x']]
+	//                   ^
+}
+
 func ExampleWithNS() {
 	xml := `
 <root xmlns="http://some.namespace.com">
@@ -165,6 +271,42 @@ func ExampleWithFunction() {
 	// Output: This is a comment.
 }
 
+func ExampleWithXPathFunction() {
+	xml := `<order><line qty="2" price="3"/><line qty="1" price="4"/></order>`
+
+	total := xsel.WithXPathFunction("acme:total", []string{"order"}, `sum($order/line/(@qty * @price)) * $tax:rate`)
+	xpath := xsel.MustBuildExpr(`acme:total(/order)`)
+	cursor, _ := xsel.ReadXml(bytes.NewBufferString(xml))
+
+	// The body is compiled with the namespaces bound when it is applied.
+	for _, ns := range []string{"http://tax/uk", "http://tax/us"} {
+		result, _ := xsel.Exec(cursor, &xpath,
+			xsel.WithNS("acme", "http://acme.com"),
+			xsel.WithNS("tax", ns),
+			xsel.WithVariableNS("http://tax/uk", "rate", xsel.Number(2)),
+			xsel.WithVariableNS("http://tax/us", "rate", xsel.Number(3)),
+			total,
+		)
+
+		fmt.Println(result)
+	}
+
+	// The query fails if the body's prefixes are not bound, or if the body
+	// is not a valid XPath query.
+	_, err := xsel.Exec(cursor, &xpath, xsel.WithNS("acme", "http://acme.com"), total)
+	fmt.Println(err)
+
+	invalid := xsel.WithXPathFunction("acme:total", []string{"order"}, `sum($order/line/`)
+	_, err = xsel.Compile(`acme:total(/order)`, xsel.WithNS("acme", "http://acme.com"), invalid)
+	syntaxErr := &xsel.SyntaxError{}
+	fmt.Println(errors.As(err, &syntaxErr))
+
+	// Output: 20
+	// 30
+	// xpath function acme:total: unknown namespace binding 'tax', in '$tax:rate' at offset 35
+	// true
+}
+
 func ExampleReadJson() {
 	json := `
 {
@@ -3,6 +3,7 @@ package exec
 import (
 	"sort"
 
+	"github.com/ChrisTrenkamp/xsel/node"
 	"github.com/ChrisTrenkamp/xsel/store"
 )
 
@@ -48,7 +49,7 @@ func cleanupBackwardAxis(nextResult NodeSet) NodeSet {
 	return unique(nextResult)
 }
 
-func selectChild(nodeSet NodeSet) Result {
+func selectChild(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -58,7 +59,7 @@ func selectChild(nodeSet NodeSet) Result {
 	return cleanupForwardAxis(result)
 }
 
-func selectAttributes(nodeSet NodeSet) Result {
+func selectAttributes(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -68,7 +69,7 @@ func selectAttributes(nodeSet NodeSet) Result {
 	return NodeSet(result)
 }
 
-func selectAncestor(nodeSet NodeSet) Result {
+func selectAncestor(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -78,7 +79,7 @@ func selectAncestor(nodeSet NodeSet) Result {
 	return cleanupBackwardAxis(result)
 }
 
-func selectAncestorOrSelf(nodeSet NodeSet) Result {
+func selectAncestorOrSelf(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -97,7 +98,34 @@ func appendAncestors(cursor store.Cursor, result []store.Cursor) []store.Cursor
 	return appendAncestors(cursor.Parent(), result)
 }
 
-func selectDescendant(nodeSet NodeSet) Result {
+// parentOf returns the parent of the cursor, or nil if it has none.  A root
+// may report its parent as nil, or as itself.
+func parentOf(cursor store.Cursor) store.Cursor {
+	parent := cursor.Parent()
+
+	if parent == nil || parent.Pos() == cursor.Pos() {
+		return nil
+	}
+
+	return parent
+}
+
+// documentRoot returns the root of the cursor's document.
+func documentRoot(cursor store.Cursor) store.Cursor {
+	for cursor.Pos() != 0 {
+		parent := parentOf(cursor)
+
+		if parent == nil {
+			break
+		}
+
+		cursor = parent
+	}
+
+	return cursor
+}
+
+func selectDescendant(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -107,7 +135,7 @@ func selectDescendant(nodeSet NodeSet) Result {
 	return cleanupForwardAxis(result)
 }
 
-func selectDescendantOrSelf(nodeSet NodeSet) Result {
+func selectDescendantOrSelf(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -127,7 +155,58 @@ func appendDescendant(cursor store.Cursor, result []store.Cursor) []store.Cursor
 	return result
 }
 
-func selectFollowing(nodeSet NodeSet) Result {
+// indexable returns the Cursor's name index, if it has one that can be used.
+func indexable(cursor store.Cursor) (store.NameIndexer, bool) {
+	index, ok := cursor.(store.NameIndexer)
+
+	if !ok {
+		return nil, false
+	}
+
+	// Attributes are also node.Element's, so they have to be ruled out first.
+	switch cursor.Node().(type) {
+	case node.Attribute:
+		return nil, false
+	case node.Element:
+		return index, true
+	}
+
+	return index, cursor.Pos() == 0
+}
+
+// selectDescendantNamed is selectDescendant for steps with a name test.  It
+// looks the names up in the Cursor's name index where possible.
+func selectDescendantNamed(nodeSet NodeSet, space, local string) NodeSet {
+	result := make([]store.Cursor, 0)
+
+	for _, i := range nodeSet {
+		if index, ok := indexable(i); ok {
+			result = append(result, index.DescendantsNamed(space, local)...)
+		} else {
+			result = appendDescendant(i, result)
+		}
+	}
+
+	return cleanupForwardAxis(result)
+}
+
+// selectFollowingNamed is selectFollowing for steps with a name test.  It
+// looks the names up in the Cursor's name index where possible.
+func selectFollowingNamed(nodeSet NodeSet, space, local string) NodeSet {
+	result := make([]store.Cursor, 0)
+
+	for _, i := range nodeSet {
+		if index, ok := indexable(i); ok {
+			result = append(result, index.FollowingNamed(space, local)...)
+		} else {
+			result = appendFollowing(i, result)
+		}
+	}
+
+	return cleanupForwardAxis(result)
+}
+
+func selectFollowing(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -161,7 +240,7 @@ func appendFollowing(cursor store.Cursor, result []store.Cursor) []store.Cursor
 	return appendFollowing(parent, result)
 }
 
-func selectFollowingSibling(nodeSet NodeSet) Result {
+func selectFollowingSibling(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -191,7 +270,7 @@ func appendFollowingSibling(cursor store.Cursor, result []store.Cursor) []store.
 	return append(result, children[start+1:]...)
 }
 
-func selectNamespace(nodeSet NodeSet) Result {
+func selectNamespace(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -201,7 +280,7 @@ func selectNamespace(nodeSet NodeSet) Result {
 	return cleanupForwardAxis(result)
 }
 
-func selectParent(nodeSet NodeSet) Result {
+func selectParent(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -211,7 +290,7 @@ func selectParent(nodeSet NodeSet) Result {
 	return cleanupForwardAxis(result)
 }
 
-func selectPreceding(nodeSet NodeSet) Result {
+func selectPreceding(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -246,7 +325,7 @@ func appendPreceding(cursor store.Cursor, result []store.Cursor) []store.Cursor
 	return appendPreceding(parent, result)
 }
 
-func selectPrecedingSibling(nodeSet NodeSet) Result {
+func selectPrecedingSibling(nodeSet NodeSet) NodeSet {
 	result := make([]store.Cursor, 0)
 
 	for _, i := range nodeSet {
@@ -0,0 +1,95 @@
+package exec
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ChrisTrenkamp/xsel/grammar"
+)
+
+// CheckError is a mistake in a XPath query found by Check.
+type CheckError struct {
+	// Span is the part of the query the mistake was found in.
+	Span Span
+	Err  error
+}
+
+func (e *CheckError) Error() string {
+	return fmt.Sprintf("%s, in '%s' at offset %d", e.Err, e.Span.Text, e.Span.Start)
+}
+
+func (e *CheckError) Unwrap() error {
+	return e.Err
+}
+
+type checker struct {
+	errs      []*CheckError
+	functions []*functionCallExpr
+	variables []*variableReferenceExpr
+}
+
+func (c *checker) fail(span Span, err error) {
+	c.errs = append(c.errs, &CheckError{Span: span, Err: err})
+}
+
+// Check reports the mistakes in a XPath query that would otherwise only
+// surface when it is executed, and only if execution happens to reach them:
+// calls to unknown functions, functions called with the wrong number of
+// arguments, unbound variables, and unknown namespace prefixes.  Only the
+// built-in functions and the ones with a Signature have their arguments
+// counted.  Functions, variables and namespaces are looked up in the given
+// settings, including their resolvers.  Every mistake is returned as a
+// *CheckError, in the order they appear in the query.
+func Check(expr *grammar.Grammar, settings ...ContextApply) []error {
+	contextSettings, err := buildContextSettings(settings...)
+
+	if err != nil {
+		return []error{err}
+	}
+
+	check := &checker{}
+	c := newCompiler(&contextSettings)
+	c.check = check
+
+	if _, err := compileRecover(c, expr); err != nil {
+		return []error{err}
+	}
+
+	for _, i := range check.functions {
+		sig, ok := contextSettings.signature(i.name)
+
+		if !ok {
+			if !contextSettings.isCustom(i.name) && !contextSettings.resolvesFunction(i.name, len(i.args)) {
+				check.fail(i.span, errorf(ErrUnknownFunction, "could not find function %s", i.name))
+			}
+
+			continue
+		}
+
+		if arity := sig.arity(); !arity.accepts(len(i.args)) {
+			check.fail(i.span, errorf(ErrArity, "function %s expects %s arguments, received %d", i.name, arity, len(i.args)))
+		}
+	}
+
+	for _, i := range check.variables {
+		if err := contextSettings.resolvesVariable(i.name); err != nil {
+			check.fail(i.span, err)
+		}
+	}
+
+	sort.SliceStable(check.errs, func(i, j int) bool {
+		return check.errs[i].Span.Start < check.errs[j].Span.Start
+	})
+
+	if len(check.errs) == 0 {
+		return nil
+	}
+
+	ret := make([]error, 0, len(check.errs))
+
+	for _, i := range check.errs {
+		ret = append(ret, i)
+	}
+
+	return ret
+}
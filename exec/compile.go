@@ -0,0 +1,235 @@
+package exec
+
+import (
+	"strings"
+
+	"github.com/ChrisTrenkamp/xsel/grammar"
+	"github.com/ChrisTrenkamp/xsel/grammar/parser/symbols"
+	"github.com/ChrisTrenkamp/xsel/grammar/token"
+	"github.com/pkg/errors"
+)
+
+// Expr is an XPath query compiled into an expression tree.  Namespace
+// prefixes, axis names, node tests and literals are resolved once when the
+// Expr is compiled, so executing it never has to walk the parse forest again.
+// An Expr is immutable, and can be executed any number of times.
+type Expr struct {
+	root exprNode
+}
+
+// exprNode is a node in a compiled expression tree.  Like the rest of the
+// engine, it reads its input from, and writes its output to, context.result.
+type exprNode interface {
+	exec(context *exprContext) error
+	explain(x *explainer)
+	spanOf() *Span
+}
+
+// Span is the part of a XPath query an expression was compiled from.
+type Span struct {
+	// Start and End are the offsets, in runes, of the expression in the query.
+	Start int
+	End   int
+	Text  string
+}
+
+// exprSpan records which part of the query an exprNode was compiled from.
+type exprSpan struct {
+	span Span
+}
+
+func (s *exprSpan) spanOf() *Span {
+	return &s.span
+}
+
+func grammarSpan(expr *grammar.Grammar) Span {
+	left, right := expr.BSR.LeftExtent(), expr.BSR.RightExtent()
+	start, end := expr.GetRuneExtents(left, right)
+
+	return Span{
+		Start: start,
+		End:   end,
+		Text:  expr.GetStringExtents(left, right),
+	}
+}
+
+func tokenSpan(tok *token.Token) Span {
+	return Span{
+		Start: tok.Lext(),
+		End:   tok.Rext(),
+		Text:  tok.LiteralString(),
+	}
+}
+
+type compileFn func(c *compiler, expr *grammar.Grammar) (exprNode, error)
+
+var compileFunctions = map[symbols.NT]compileFn{}
+
+type compiler struct {
+	namespaces map[string]string
+	// expr is the part of the query being compiled.
+	expr *grammar.Grammar
+	// check is non-nil when the query is being compiled by Check.
+	check *checker
+	// scope lists the variables bound in the query by the expressions
+	// enclosing the part of it being compiled.
+	scope []XmlName
+}
+
+// Compile builds an expression tree from the given XPath query.  Namespace
+// bindings are resolved from the given settings when the expression is
+// compiled.  Variables and functions are resolved when the expression is
+// executed.
+func Compile(expr *grammar.Grammar, settings ...ContextApply) (*Expr, error) {
+	contextSettings, err := buildContextSettings(settings...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return compileRecover(newCompiler(&contextSettings), expr)
+}
+
+// MustCompile is like Compile, but panics if an error is thrown.
+func MustCompile(expr *grammar.Grammar, settings ...ContextApply) *Expr {
+	ret, err := Compile(expr, settings...)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return ret
+}
+
+func newCompiler(settings *ContextSettings) *compiler {
+	return &compiler{
+		namespaces: settings.NamespaceDecls,
+	}
+}
+
+func compileRecover(c *compiler, expr *grammar.Grammar) (ret *Expr, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Wrapf(errorf(ErrPanic, "xpath compile panic"), "%s", r)
+		}
+	}()
+
+	root, err := c.compile(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Expr{root: root}, nil
+}
+
+func (c *compiler) compile(expr *grammar.Grammar) (exprNode, error) {
+	name := expr.BSR.Label.Slot().NT
+	compile := compileFunctions[name]
+
+	parent := c.expr
+	c.expr = expr
+	defer func() { c.expr = parent }()
+
+	var ret exprNode
+	var err error
+
+	if compile != nil {
+		ret, err = compile(c, expr)
+	} else {
+		ret, err = c.compileChildren(expr)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Expressions that pass straight through to their child keep the
+	// child's span.
+	if span := ret.spanOf(); span.End == 0 {
+		*span = grammarSpan(expr)
+	}
+
+	return ret, nil
+}
+
+// fail reports an error in the part of the query being compiled, caused by
+// name.  It returns nil if the query is being checked.
+func (c *compiler) fail(name string, err error) error {
+	span := grammarSpan(c.expr)
+
+	if c.check == nil {
+		return &ExprError{Name: name, Span: span, Err: err}
+	}
+
+	c.check.fail(span, err)
+	return nil
+}
+
+// getQName resolves the prefix of a QName.  It returns false if the prefix
+// could not be resolved and the query is being checked.
+func (c *compiler) getQName(input string) (XmlName, bool, error) {
+	qname, err := GetQName(input, c.namespaces)
+
+	if err != nil {
+		prefix, _, _ := strings.Cut(input, ":")
+		return XmlName{Local: input}, false, c.fail(strings.TrimSpace(prefix), err)
+	}
+
+	return qname, true, nil
+}
+
+func (c *compiler) compileChildren(expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
+
+	if len(children) == 0 {
+		return &selfExpr{}, nil
+	}
+
+	return c.compile(children[0])
+}
+
+func (c *compiler) compileLeftRight(expr *grammar.Grammar) (exprNode, exprNode, error) {
+	children := ntChildren(expr)
+
+	left, err := c.compile(children[0])
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	right, err := c.compile(children[1])
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return left, right, nil
+}
+
+// ntChildren returns the nonterminal children of the given expression.  If a
+// child was parsed ambiguously, only the first parse is returned.
+func ntChildren(expr *grammar.Grammar) []*grammar.Grammar {
+	children := make([]*grammar.Grammar, 0, 2)
+
+	for _, cn := range expr.BSR.GetAllNTChildren() {
+		if len(cn) > 0 {
+			children = append(children, expr.Next(&cn[0]))
+		}
+	}
+
+	return children
+}
+
+// selfExpr leaves the context untouched.
+type selfExpr struct {
+	exprSpan
+}
+
+func (selfExpr) exec(context *exprContext) error {
+	return nil
+}
+
+func (selfExpr) explain(x *explainer) {
+	x.line("Context")
+}
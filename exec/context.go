@@ -1,28 +1,134 @@
 package exec
 
-import "github.com/ChrisTrenkamp/xsel/store"
+import (
+	"context"
+
+	"github.com/ChrisTrenkamp/xsel/store"
+)
 
 // ContextSettings allows you to add namespace mappings, create new functions,
 // and add variable bindings to your XPath query.
 type ContextSettings struct {
 	NamespaceDecls  map[string]string
 	FunctionLibrary map[XmlName]Function
-	Variables       map[XmlName]Result
+	// FunctionSignatures holds the Signatures of the functions in the
+	// FunctionLibrary and LazyFunctions that declared one.
+	FunctionSignatures map[XmlName]Signature
+	// LazyFunctions are called with their arguments unevaluated.  They take
+	// precedence over the FunctionLibrary.
+	LazyFunctions map[XmlName]LazyFunction
+	Variables     map[XmlName]Result
+	// VariableResolver, if set, is asked for the variables that are not
+	// bound in Variables.
+	VariableResolver VariableResolver
+	// FunctionResolver, if set, is asked for the functions that are neither
+	// custom nor built-in functions.
+	FunctionResolver FunctionResolver
+	// MaxNodesVisited limits the number of nodes a query may select along
+	// its axes.  Zero means there is no limit.
+	MaxNodesVisited int
+	// MaxDepth limits how deeply expressions may be nested while a query is
+	// evaluated.  Zero means there is no limit.
+	MaxDepth int
+	// MaxNodeSetSize limits the size of every NodeSet and Sequence produced
+	// while a query is evaluated.  Zero means there is no limit, though ranges
+	// are always limited to MaxRangeSize numbers.
+	MaxNodeSetSize int
+	// MaxRecursion limits how deeply XPathFunctions may call each other.  It
+	// defaults to DefaultMaxRecursion.  Zero means there is no limit.
+	MaxRecursion int
+	// DocumentRoot is the node absolute paths select from.  It defaults to
+	// the node the query is executed against, so set it to evaluate a query
+	// relative to a node inside a document.
+	DocumentRoot store.Cursor
+	// Position and Size are what position() and last() return for the node
+	// the query is executed against.  Position is one-based, and defaults to
+	// 1.  Size defaults to Position.
+	Position int
+	Size     int
+	// Tracer, if set, is notified as each part of a query is evaluated.
+	Tracer Tracer
+	// IDAttributes lists the attributes the id() function treats as IDs.
+	// It defaults to xml:id.
+	IDAttributes []XmlName
+	// DTDIDs makes the id() function also treat the attributes that were
+	// declared with the ID type in the document's DTD as IDs.  It defaults
+	// to true.
+	DTDIDs bool
+	// err is the first error recorded with Fail.
+	err error
+}
+
+// Fail records an error found while the settings are applied, such as a
+// function that could not be bound.  Queries with the settings fail with the
+// first error recorded, instead of being compiled or executed.
+func (c *ContextSettings) Fail(err error) {
+	if c.err == nil {
+		c.err = err
+	}
 }
 
 type ContextApply func(c *ContextSettings)
 
+// evalState is shared by every copy of an exprContext for the duration of a
+// single query.
+type evalState struct {
+	ctx          context.Context
+	nodesVisited int
+	depth        int
+	// hoisted caches the results of the hoistedExpr's.
+	hoisted map[*hoistedExpr]Result
+	// recursion is the number of XPathFunction calls being evaluated.
+	recursion int
+	// variables and functions cache what the resolvers returned.
+	variables map[XmlName]Result
+	functions map[resolvedFunction]Function
+	// ids caches the ID indexes of documents that are not store.Memoizer's.
+	ids map[idIndexKey]idIndex
+}
+
 type exprContext struct {
 	root             store.Cursor
 	result           Result
 	contextPosition  int
+	contextSize      int
+	sizer            func() int
 	builtinFunctions map[XmlName]Function
+	state            *evalState
+	// scope holds the variables bound in the query by the expressions being
+	// evaluated.
+	scope *scope
 	ContextSettings
 }
 
+// isCustom reports whether the function is in the FunctionLibrary or the
+// LazyFunctions, which replace the built-in functions of the same name.
+func (c *ContextSettings) isCustom(name XmlName) bool {
+	return c.FunctionLibrary[name] != nil || c.LazyFunctions[name] != nil
+}
+
+// Context is what a Function is called with.
 type Context interface {
 	Result() Result
+	// ContextPosition is the zero-based position of the context node.
 	ContextPosition() int
+	// ContextSize is the number of nodes the context node was selected
+	// with, such as the nodes a predicate is filtering.
+	ContextSize() int
+	// Root is the root of the document being queried.
+	Root() store.Cursor
+	// Namespaces returns the namespace bindings of the query.  The map must
+	// not be modified.
+	Namespaces() map[string]string
+	// ResolveQName resolves a prefixed name with the query's namespace
+	// bindings.
+	ResolveQName(qname string) (XmlName, error)
+	// Variable looks up a variable bound to the query, including through
+	// the VariableResolver.  Variables the resolver fails on are not found.
+	Variable(name XmlName) (Result, bool)
+	// Evaluate evaluates a compiled XPath query against the given node,
+	// with the same settings as the query that called the Function.
+	Evaluate(expr *Expr, node store.Cursor) (Result, error)
 }
 
 func (c *exprContext) Result() Result {
@@ -33,12 +139,61 @@ func (c *exprContext) ContextPosition() int {
 	return c.contextPosition
 }
 
+// ContextSize counts the nodes with sizer the first time it is called, since
+// streamed predicates only count their nodes if they have to.
+func (c *exprContext) ContextSize() int {
+	if c.sizer != nil {
+		c.contextSize = c.sizer()
+		c.sizer = nil
+	}
+
+	return c.contextSize
+}
+
+func (c *exprContext) Root() store.Cursor {
+	return c.root
+}
+
+func (c *exprContext) Namespaces() map[string]string {
+	return c.NamespaceDecls
+}
+
+func (c *exprContext) ResolveQName(qname string) (XmlName, error) {
+	return GetQName(qname, c.NamespaceDecls)
+}
+
+func (c *exprContext) Variable(name XmlName) (Result, bool) {
+	variable, ok, err := c.variable(name)
+	return variable, ok && err == nil
+}
+
+func (c *exprContext) Evaluate(expr *Expr, node store.Cursor) (Result, error) {
+	next := c.copy()
+	next.result = NodeSet{node}
+	next.contextPosition = 0
+	next.contextSize = 1
+	next.sizer = nil
+	// expr was compiled on its own, so it cannot see the caller's let and
+	// for variables.
+	next.scope = nil
+
+	if err := execContext(&next, expr.root); err != nil {
+		return nil, err
+	}
+
+	return next.result, nil
+}
+
 func (e *exprContext) copy() exprContext {
 	return exprContext{
 		root:             e.root,
 		result:           e.result,
 		contextPosition:  e.contextPosition,
+		contextSize:      e.contextSize,
+		sizer:            e.sizer,
 		builtinFunctions: builtinFunctions,
+		state:            e.state,
+		scope:            e.scope,
 		ContextSettings:  e.ContextSettings,
 	}
 }
@@ -2,47 +2,56 @@ package exec
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/ChrisTrenkamp/xsel/grammar"
-	"github.com/ChrisTrenkamp/xsel/grammar/parser/bsr"
 	"github.com/ChrisTrenkamp/xsel/grammar/parser/symbols"
 )
 
-type contextFn func(context *exprContext, expr *grammar.Grammar) error
-
-var contextFunctions = map[symbols.NT]contextFn{}
-
 func init() {
-	contextFunctions[symbols.NT_Literal] = execLiteral
-	contextFunctions[symbols.NT_UnionExprUnion] = execUnionExprUnion
-	contextFunctions[symbols.NT_FunctionCall] = execFunctionCall
-	contextFunctions[symbols.NT_VariableReference] = execVariableReference
+	compileFunctions[symbols.NT_Literal] = compileLiteral
+	compileFunctions[symbols.NT_UnionExprUnion] = compileBinary("|", execUnionExprUnion)
+	compileFunctions[symbols.NT_FunctionCall] = compileFunctionCall
+	compileFunctions[symbols.NT_VariableReference] = compileVariableReference
 }
 
-func execLiteral(context *exprContext, expr *grammar.Grammar) error {
-	literal := expr.GetString()
-	literal = literal[1 : len(literal)-1]
+// literalExpr is a string or number literal.
+type literalExpr struct {
+	exprSpan
+	value Result
+}
 
-	context.result = String(literal)
+func (e *literalExpr) exec(context *exprContext) error {
+	context.result = e.value
 	return nil
 }
 
-func execUnionExprUnion(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentResult(context, expr)
-
-	if err != nil {
-		return err
+func (e *literalExpr) explain(x *explainer) {
+	if _, ok := e.value.(String); ok {
+		x.line("Literal %q", e.value.String())
+		return
 	}
 
+	x.line("Number %s", e.value.String())
+}
+
+func compileLiteral(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	literal := expr.GetString()
+	literal = literal[1 : len(literal)-1]
+
+	return &literalExpr{value: String(literal)}, nil
+}
+
+func execUnionExprUnion(context *exprContext, left, right Result) error {
 	leftNodeSet, lok := left.(NodeSet)
 	rightNodeSet, rok := right.(NodeSet)
 
 	if !lok || !rok {
-		return fmt.Errorf("cannot union non-NodeSet's")
+		return errorf(ErrNotNodeSet, "cannot union non-NodeSet's")
 	}
 
-	context.result = unionCleanup(append(leftNodeSet, rightNodeSet...))
+	union := make(NodeSet, 0, len(leftNodeSet)+len(rightNodeSet))
+	union = append(union, leftNodeSet...)
+	context.result = unionCleanup(append(union, rightNodeSet...))
 	return nil
 }
 
@@ -53,51 +62,65 @@ func unionCleanup(nextResult NodeSet) NodeSet {
 	return unique(cleanupForwardAxis(nextResult))
 }
 
-func execFunctionCall(context *exprContext, expr *grammar.Grammar) error {
-	children := make([]*bsr.BSR, 0, 2)
+type functionCallExpr struct {
+	exprSpan
+	name XmlName
+	args []exprNode
+}
+
+var (
+	booleanName = XmlName{Local: "boolean"}
+	notName     = XmlName{Local: "not"}
+)
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			children = append(children, &c)
+func (e *functionCallExpr) exec(context *exprContext) error {
+	// boolean() and not() only need to know whether a NodeSet is empty, so
+	// their argument is only evaluated up to its first node.
+	if (e.name == booleanName || e.name == notName) && len(e.args) == 1 && !context.isCustom(e.name) {
+		b, err := execBool(context, e.args[0])
+
+		if err != nil {
+			return err
 		}
+
+		context.result = Bool(b == (e.name == booleanName))
+		return nil
 	}
 
-	bsrs := make([]*bsr.BSR, 0)
-	gatherFunctionArgs(children[1], &bsrs)
+	if lazy := context.LazyFunctions[e.name]; lazy != nil {
+		return e.execLazy(context, lazy)
+	}
 
-	args := make([]Result, 0, len(bsrs))
+	args := make([]Result, 0, len(e.args))
 
-	for _, i := range bsrs {
-		nextContext := context.copy()
-		nextExpr := expr.Next(i)
+	for _, i := range e.args {
+		arg, err := execIndependent(context, i)
 
-		if err := execContext(&nextContext, nextExpr); err != nil {
+		if err != nil {
 			return err
 		}
 
-		args = append(args, nextContext.result)
+		args = append(args, arg)
 	}
 
-	qname, err := GetQName(expr.Next(children[0]).GetString(), context.NamespaceDecls)
+	fn := context.FunctionLibrary[e.name]
 
-	if err != nil {
-		return err
+	if fn == nil {
+		fn = context.builtinFunctions[e.name]
 	}
 
-	fn := context.FunctionLibrary[qname]
-
 	if fn == nil {
-		fn = context.builtinFunctions[qname]
+		fn = context.resolveFunction(e.name, len(e.args))
 	}
 
 	if fn == nil {
-		return fmt.Errorf("could not find function %s", qname)
+		return e.fail(errorf(ErrUnknownFunction, "could not find function %s", e.name))
 	}
 
 	result, err := fn(context, args...)
 
 	if err != nil {
-		return fmt.Errorf("error invoking function %s: %s", qname, err)
+		return e.fail(fmt.Errorf("error invoking function %s: %w", e.name, err))
 	}
 
 	context.result = result
@@ -105,16 +128,72 @@ func execFunctionCall(context *exprContext, expr *grammar.Grammar) error {
 	return nil
 }
 
-func gatherFunctionArgs(b *bsr.BSR, args *[]*bsr.BSR) {
-	children := make([]*bsr.BSR, 0, 2)
+func (e *functionCallExpr) execLazy(context *exprContext, fn LazyFunction) error {
+	args := make([]Thunk, 0, len(e.args))
+	caller := context.copy()
+
+	for _, i := range e.args {
+		args = append(args, newThunk(&caller, i))
+	}
+
+	result, err := fn(context, args...)
+
+	if err != nil {
+		return e.fail(fmt.Errorf("error invoking function %s: %w", e.name, err))
+	}
+
+	context.result = result
+	return nil
+}
+
+func (e *functionCallExpr) fail(err error) error {
+	return &ExprError{Name: e.name.String(), Span: e.span, Err: err}
+}
+
+func (e *functionCallExpr) explain(x *explainer) {
+	x.line("FunctionCall %s/%d", e.name, len(e.args))
+	x.children(e.args...)
+}
+
+func compileFunctionCall(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
+
+	qname, resolved, err := c.getQName(children[0].GetTokenString())
+
+	if err != nil {
+		return nil, err
+	}
+
+	argExprs := make([]*grammar.Grammar, 0)
+	gatherFunctionArgs(children[1], &argExprs)
+
+	args := make([]exprNode, 0, len(argExprs))
 
-	for _, cn := range b.GetAllNTChildren() {
-		for _, c := range cn {
-			children = append(children, &c)
+	for _, i := range argExprs {
+		arg, err := c.compile(i)
+
+		if err != nil {
+			return nil, err
 		}
+
+		args = append(args, arg)
 	}
 
-	name := b.Label.Slot().NT
+	ret := &functionCallExpr{
+		name: qname,
+		args: args,
+	}
+
+	if c.check != nil && resolved {
+		c.check.functions = append(c.check.functions, ret)
+	}
+
+	return ret, nil
+}
+
+func gatherFunctionArgs(expr *grammar.Grammar, args *[]*grammar.Grammar) {
+	children := ntChildren(expr)
+	name := expr.BSR.Label.Slot().NT
 
 	if (name == symbols.NT_FunctionSignature || name == symbols.NT_FunctionCallArgumentList) && len(children) == 1 {
 		gatherFunctionArgs(children[0], args)
@@ -130,23 +209,45 @@ func gatherFunctionArgs(b *bsr.BSR, args *[]*bsr.BSR) {
 	}
 }
 
-func execVariableReference(context *exprContext, expr *grammar.Grammar) error {
-	variableStr := expr.Next(expr.BSR).GetString()
-	variableStr = strings.TrimSpace(variableStr)
-	variableStr = strings.TrimPrefix(variableStr, "$")
+type variableReferenceExpr struct {
+	exprSpan
+	name XmlName
+	// bound is set when the variable is bound in the query by an enclosing
+	// expression, rather than by the settings.
+	bound bool
+}
 
-	qname, err := GetQName(variableStr, context.NamespaceDecls)
+func (e *variableReferenceExpr) exec(context *exprContext) error {
+	variable, ok, err := context.variable(e.name)
 
-	if err != nil {
-		return err
+	if err == nil && !ok {
+		err = errorf(ErrUnboundVariable, "could not find variable %s", e.name)
 	}
 
-	variable := context.Variables[qname]
-
-	if variable == nil {
-		return fmt.Errorf("could not find variable %s", qname)
+	if err != nil {
+		return &ExprError{Name: e.name.String(), Span: e.span, Err: err}
 	}
 
 	context.result = variable
 	return nil
 }
+
+func (e *variableReferenceExpr) explain(x *explainer) {
+	x.line("Variable $%s", e.name)
+}
+
+func compileVariableReference(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	qname, resolved, err := c.variableName(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &variableReferenceExpr{name: qname, bound: c.bound(qname)}
+
+	if c.check != nil && resolved && !ret.bound {
+		c.check.variables = append(c.check.variables, ret)
+	}
+
+	return ret, nil
+}
@@ -6,51 +6,99 @@ import (
 )
 
 func init() {
-	contextFunctions[symbols.NT_OrExprOr] = execOrExprOr
-	contextFunctions[symbols.NT_AndExprAnd] = execAndExprAnd
-	contextFunctions[symbols.NT_EqualityExprEqual] = execEqualityExprEqual
-	contextFunctions[symbols.NT_EqualityExprNotEqual] = execEqualityExprNotEqual
-	contextFunctions[symbols.NT_RelationalExprLessThan] = execRelationalExprLessThan
-	contextFunctions[symbols.NT_RelationalExprGreaterThan] = execRelationalExprGreaterThan
-	contextFunctions[symbols.NT_RelationalExprLessThanOrEqual] = execRelationalExprLessThanOrEqual
-	contextFunctions[symbols.NT_RelationalExprGreaterThanOrEqual] = execRelationalExprGreaterThanOrEqual
+	compileFunctions[symbols.NT_OrExprOr] = compileLogical("or")
+	compileFunctions[symbols.NT_AndExprAnd] = compileLogical("and")
+	compileFunctions[symbols.NT_EqualityExprEqual] = compileBinary("=", compareItems(execEqualityExprEqual))
+	compileFunctions[symbols.NT_EqualityExprNotEqual] = compileBinary("!=", compareItems(execEqualityExprNotEqual))
+	compileFunctions[symbols.NT_RelationalExprLessThan] = compileBinary("<", compareItems(execRelationalExprLessThan))
+	compileFunctions[symbols.NT_RelationalExprGreaterThan] = compileBinary(">", compareItems(execRelationalExprGreaterThan))
+	compileFunctions[symbols.NT_RelationalExprLessThanOrEqual] = compileBinary("<=", compareItems(execRelationalExprLessThanOrEqual))
+	compileFunctions[symbols.NT_RelationalExprGreaterThanOrEqual] = compileBinary(">=", compareItems(execRelationalExprGreaterThanOrEqual))
 }
 
-func execOrExprOr(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentResult(context, expr)
+// logicalExpr is the "and" and "or" operators.  The right operand is only
+// evaluated if the left one does not decide the result.
+type logicalExpr struct {
+	exprSpan
+	op    string
+	left  exprNode
+	right exprNode
+}
+
+func (e *logicalExpr) exec(context *exprContext) error {
+	left, err := execBool(context, e.left)
 
 	if err != nil {
 		return err
 	}
 
-	leftBool := left.Bool()
-	rightBool := right.Bool()
-
-	context.result = Bool(leftBool || rightBool)
-	return nil
-}
+	if left == (e.op == "or") {
+		context.result = Bool(left)
+		return nil
+	}
 
-func execAndExprAnd(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentResult(context, expr)
+	right, err := execBool(context, e.right)
 
 	if err != nil {
 		return err
 	}
 
-	leftBool := left.Bool()
-	rightBool := right.Bool()
-
-	context.result = Bool(leftBool && rightBool)
+	context.result = Bool(right)
 	return nil
 }
 
-func execEqualityExprEqual(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentResult(context, expr)
+func (e *logicalExpr) explain(x *explainer) {
+	x.line("Operator %s", e.op)
+	x.children(e.left, e.right)
+}
 
-	if err != nil {
-		return err
+func compileLogical(op string) compileFn {
+	return func(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+		left, right, err := c.compileLeftRight(expr)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &logicalExpr{
+			op:    op,
+			left:  left,
+			right: right,
+		}, nil
+	}
+}
+
+// compareItems compares Sequences like NodeSets are compared: the comparison
+// is true if it is true for any pair of their items.
+func compareItems(fn binaryFn) binaryFn {
+	return func(context *exprContext, left, right Result) error {
+		_, leftSequence := left.(Sequence)
+		_, rightSequence := right.(Sequence)
+
+		if !leftSequence && !rightSequence {
+			return fn(context, left, right)
+		}
+
+		rightItems := Items(right)
+
+		for _, l := range Items(left) {
+			for _, r := range rightItems {
+				if err := fn(context, l, r); err != nil {
+					return err
+				}
+
+				if context.result.Bool() {
+					return nil
+				}
+			}
+		}
+
+		context.result = Bool(false)
+		return nil
 	}
+}
 
+func execEqualityExprEqual(context *exprContext, left, right Result) error {
 	leftNodeSet, leftNodeSetOk := left.(NodeSet)
 	rightNodeSet, rightNodeSetOk := right.(NodeSet)
 
@@ -152,13 +200,7 @@ func execEqualityExprEqual(context *exprContext, expr *grammar.Grammar) error {
 	return nil
 }
 
-func execEqualityExprNotEqual(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentResult(context, expr)
-
-	if err != nil {
-		return err
-	}
-
+func execEqualityExprNotEqual(context *exprContext, left, right Result) error {
 	leftNodeSet, leftNodeSetOk := left.(NodeSet)
 	rightNodeSet, rightNodeSetOk := right.(NodeSet)
 
@@ -260,13 +302,7 @@ func execEqualityExprNotEqual(context *exprContext, expr *grammar.Grammar) error
 	return nil
 }
 
-func execRelationalExprLessThan(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentResult(context, expr)
-
-	if err != nil {
-		return err
-	}
-
+func execRelationalExprLessThan(context *exprContext, left, right Result) error {
 	leftNodeSet, leftNodeSetOk := left.(NodeSet)
 	rightNodeSet, rightNodeSetOk := right.(NodeSet)
 
@@ -344,13 +380,7 @@ func execRelationalExprLessThan(context *exprContext, expr *grammar.Grammar) err
 	return nil
 }
 
-func execRelationalExprLessThanOrEqual(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentResult(context, expr)
-
-	if err != nil {
-		return err
-	}
-
+func execRelationalExprLessThanOrEqual(context *exprContext, left, right Result) error {
 	leftNodeSet, leftNodeSetOk := left.(NodeSet)
 	rightNodeSet, rightNodeSetOk := right.(NodeSet)
 
@@ -428,13 +458,7 @@ func execRelationalExprLessThanOrEqual(context *exprContext, expr *grammar.Gramm
 	return nil
 }
 
-func execRelationalExprGreaterThan(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentResult(context, expr)
-
-	if err != nil {
-		return err
-	}
-
+func execRelationalExprGreaterThan(context *exprContext, left, right Result) error {
 	leftNodeSet, leftNodeSetOk := left.(NodeSet)
 	rightNodeSet, rightNodeSetOk := right.(NodeSet)
 
@@ -512,13 +536,7 @@ func execRelationalExprGreaterThan(context *exprContext, expr *grammar.Grammar)
 	return nil
 }
 
-func execRelationalExprGreaterThanOrEqual(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentResult(context, expr)
-
-	if err != nil {
-		return err
-	}
-
+func execRelationalExprGreaterThanOrEqual(context *exprContext, left, right Result) error {
 	leftNodeSet, leftNodeSetOk := left.(NodeSet)
 	rightNodeSet, rightNodeSetOk := right.(NodeSet)
 
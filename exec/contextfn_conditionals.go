@@ -0,0 +1,140 @@
+package exec
+
+import (
+	"github.com/ChrisTrenkamp/xsel/grammar"
+	"github.com/ChrisTrenkamp/xsel/grammar/parser/symbols"
+)
+
+func init() {
+	compileFunctions[symbols.NT_IfExpr] = compileIfExpr
+	compileFunctions[symbols.NT_QuantifiedExprSome] = compileQuantifiedExpr(false)
+	compileFunctions[symbols.NT_QuantifiedExprEvery] = compileQuantifiedExpr(true)
+}
+
+// ifExpr is the XPath 2.0 "if (cond) then a else b" expression.  Only the
+// branch the condition selects is evaluated.
+type ifExpr struct {
+	exprSpan
+	cond exprNode
+	then exprNode
+	els  exprNode
+}
+
+func (e *ifExpr) exec(context *exprContext) error {
+	cond, err := execBool(context, e.cond)
+
+	if err != nil {
+		return err
+	}
+
+	branch := e.els
+
+	if cond {
+		branch = e.then
+	}
+
+	result, err := execIndependent(context, branch)
+
+	if err != nil {
+		return err
+	}
+
+	context.result = result
+	return nil
+}
+
+func (e *ifExpr) explain(x *explainer) {
+	x.line("If")
+	x.children(e.cond, e.then, e.els)
+	x.extension("if expression")
+}
+
+func compileIfExpr(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
+	branches := make([]exprNode, 0, len(children))
+
+	for _, i := range children {
+		branch, err := c.compile(i)
+
+		if err != nil {
+			return nil, err
+		}
+
+		branches = append(branches, branch)
+	}
+
+	return &ifExpr{
+		cond: branches[0],
+		then: branches[1],
+		els:  branches[2],
+	}, nil
+}
+
+// quantifiedExpr is the XPath 2.0 "some $x in items satisfies test" and
+// "every $x in items satisfies test" expressions.  The items are only read up
+// to the first one that decides the result.
+type quantifiedExpr struct {
+	exprSpan
+	every bool
+	name  XmlName
+	in    exprNode
+	test  exprNode
+}
+
+func (e *quantifiedExpr) exec(context *exprContext) error {
+	ret := e.every
+
+	err := eachItem(context, e.in, func(item Result) (bool, error) {
+		next := context.bind(e.name, item)
+		satisfied, err := execBool(&next, e.test)
+
+		if err != nil {
+			return false, err
+		}
+
+		if satisfied != e.every {
+			ret = satisfied
+			return false, nil
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	context.result = Bool(ret)
+	return nil
+}
+
+func (e *quantifiedExpr) explain(x *explainer) {
+	op := "Some"
+
+	if e.every {
+		op = "Every"
+	}
+
+	x.line("%s $%s", op, e.name)
+	x.children(e.in, e.test)
+	x.extension("quantified expression")
+}
+
+func compileQuantifiedExpr(every bool) compileFn {
+	return func(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+		children := ntChildren(expr)
+		in, err := c.compile(children[1])
+
+		if err != nil {
+			return nil, err
+		}
+
+		name, test, err := c.compileScope(children[0], children[2])
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &quantifiedExpr{every: every, name: name, in: in, test: test}, nil
+	}
+}
@@ -1,98 +1,128 @@
 package exec
 
 import (
+	"time"
+
 	"github.com/ChrisTrenkamp/xsel/grammar"
-	"github.com/ChrisTrenkamp/xsel/grammar/parser/bsr"
 )
 
-func execContext(context *exprContext, expr *grammar.Grammar) error {
-	name := expr.BSR.Label.Slot().NT
-	exec := contextFunctions[name]
+// execContext evaluates expr against the context.  Every node in an expression
+// tree is evaluated through execContext, so it is where cancellation and the
+// evaluation budgets are enforced, and where the Tracer is notified.
+func execContext(context *exprContext, expr exprNode) error {
+	if context.Tracer == nil {
+		return execNode(context, expr)
+	}
 
-	if exec != nil {
-		return exec(context, expr)
+	event := TraceEvent{
+		Span:      *expr.spanOf(),
+		InputSize: resultSize(context.result),
+		node:      expr,
 	}
 
-	return execChildren(context, expr)
-}
+	context.Tracer.Enter(event)
 
-func leftOnlyIndependentResult(context *exprContext, expr *grammar.Grammar) (Result, error) {
-	var execNext *bsr.BSR
+	start := time.Now()
+	err := execNode(context, expr)
+	event.Elapsed = time.Since(start)
+	event.OutputSize = -1
+	event.Err = err
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			execNext = &c
-			break
-		}
+	if err == nil {
+		event.OutputSize = resultSize(context.result)
 	}
 
-	left := context.copy()
+	context.Tracer.Leave(event)
+	return err
+}
 
-	if err := execContext(&left, expr.Next(execNext)); err != nil {
-		return nil, err
-	}
+func execNode(context *exprContext, expr exprNode) error {
+	state := context.state
 
-	return left.result, nil
-}
+	if err := state.ctx.Err(); err != nil {
+		return err
+	}
 
-func leftRightIndependentResult(context *exprContext, expr *grammar.Grammar) (Result, Result, error) {
-	children := make([]*bsr.BSR, 0, 2)
+	state.depth++
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			children = append(children, &c)
-		}
+	if context.MaxDepth > 0 && state.depth > context.MaxDepth {
+		return &LimitError{Limit: LimitDepth, Max: context.MaxDepth}
 	}
 
-	left := context.copy()
-	right := context.copy()
+	err := expr.exec(context)
+	state.depth--
 
-	if err := execContext(&left, expr.Next(children[0])); err != nil {
-		return nil, nil, err
+	if err != nil {
+		return exprError(expr, err)
 	}
 
-	if err := execContext(&right, expr.Next(children[1])); err != nil {
-		return nil, nil, err
+	switch result := context.result.(type) {
+	case NodeSet:
+		return context.checkNodeSetSize(result)
+	case Sequence:
+		return context.checkSize(len(result))
 	}
 
-	return left.result, right.result, nil
+	return nil
 }
 
-func leftRightIndependentNumber(context *exprContext, expr *grammar.Grammar) (float64, float64, error) {
-	left, right, err := leftRightIndependentResult(context, expr)
+// execIndependent evaluates expr against a copy of the context, leaving the
+// original context untouched.
+func execIndependent(context *exprContext, expr exprNode) (Result, error) {
+	next := context.copy()
 
-	if err != nil {
-		return 0, 0, err
+	if err := execContext(&next, expr); err != nil {
+		return nil, err
 	}
 
-	leftNum := left.Number()
-	rightNum := right.Number()
-
-	return leftNum, rightNum, nil
+	return next.result, nil
 }
 
-func execChildren(context *exprContext, expr *grammar.Grammar) error {
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			return execContext(context, expr.Next(&c))
-		}
-	}
+type binaryFn func(context *exprContext, left, right Result) error
 
-	return nil
+// binaryExpr evaluates both operands independently against the same context.
+type binaryExpr struct {
+	exprSpan
+	op    string
+	left  exprNode
+	right exprNode
+	fn    binaryFn
 }
 
-func leftRightDependentResult(context *exprContext, expr *grammar.Grammar) error {
-	children := make([]*bsr.BSR, 0, 2)
+func (e *binaryExpr) exec(context *exprContext) error {
+	left, err := execIndependent(context, e.left)
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			children = append(children, &c)
-		}
+	if err != nil {
+		return err
 	}
 
-	if err := execContext(context, expr.Next(children[0])); err != nil {
+	right, err := execIndependent(context, e.right)
+
+	if err != nil {
 		return err
 	}
 
-	return execContext(context, expr.Next(children[1]))
+	return e.fn(context, left, right)
+}
+
+func (e *binaryExpr) explain(x *explainer) {
+	x.line("Operator %s", e.op)
+	x.children(e.left, e.right)
+}
+
+func compileBinary(op string, fn binaryFn) compileFn {
+	return func(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+		left, right, err := c.compileLeftRight(expr)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &binaryExpr{
+			op:    op,
+			left:  left,
+			right: right,
+			fn:    fn,
+		}, nil
+	}
 }
@@ -9,67 +9,49 @@ import (
 )
 
 func init() {
-	contextFunctions[symbols.NT_Number] = execNumber
-	contextFunctions[symbols.NT_AdditiveExprAdd] = execAdditiveExprAdd
-	contextFunctions[symbols.NT_AdditiveExprSubtract] = execAdditiveExprSubtract
-	contextFunctions[symbols.NT_MultiplicativeExprMultiply] = execMultiplicativeExprMultiply
-	contextFunctions[symbols.NT_MultiplicativeExprDivide] = execMultiplicativeExprDivide
-	contextFunctions[symbols.NT_MultiplicativeExprMod] = execMultiplicativeExprMod
-	contextFunctions[symbols.NT_UnaryExprNegate] = execUnaryExprNegate
+	compileFunctions[symbols.NT_Number] = compileNumber
+	compileFunctions[symbols.NT_AdditiveExprAdd] = compileBinary("+", execAdditiveExprAdd)
+	compileFunctions[symbols.NT_AdditiveExprSubtract] = compileBinary("-", execAdditiveExprSubtract)
+	compileFunctions[symbols.NT_MultiplicativeExprMultiply] = compileBinary("*", execMultiplicativeExprMultiply)
+	compileFunctions[symbols.NT_MultiplicativeExprDivide] = compileBinary("div", execMultiplicativeExprDivide)
+	compileFunctions[symbols.NT_MultiplicativeExprMod] = compileBinary("mod", execMultiplicativeExprMod)
+	compileFunctions[symbols.NT_UnaryExprNegate] = compileUnaryExprNegate
 }
 
-func execNumber(context *exprContext, expr *grammar.Grammar) error {
-	numStr := expr.GetString()
+func compileNumber(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	numStr := expr.GetTokenString()
 	numResult, err := strconv.ParseFloat(numStr, 64)
 
-	context.result = Number(numResult)
-	return err
-}
-
-func execAdditiveExprAdd(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentNumber(context, expr)
-
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	context.result = Number(left + right)
-	return nil
+	return &literalExpr{value: Number(numResult)}, nil
 }
 
-func execAdditiveExprSubtract(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentNumber(context, expr)
-
-	if err != nil {
-		return err
-	}
-
-	context.result = Number(left - right)
+func execAdditiveExprAdd(context *exprContext, left, right Result) error {
+	context.result = Number(left.Number() + right.Number())
 	return nil
 }
 
-func execMultiplicativeExprMultiply(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentNumber(context, expr)
-
-	if err != nil {
-		return err
-	}
-
-	context.result = Number(left * right)
+func execAdditiveExprSubtract(context *exprContext, left, right Result) error {
+	context.result = Number(left.Number() - right.Number())
 	return nil
 }
 
-func execMultiplicativeExprDivide(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentNumber(context, expr)
+func execMultiplicativeExprMultiply(context *exprContext, left, right Result) error {
+	context.result = Number(left.Number() * right.Number())
+	return nil
+}
 
-	if err != nil {
-		return err
-	}
+func execMultiplicativeExprDivide(context *exprContext, left, right Result) error {
+	leftNum := left.Number()
+	rightNum := right.Number()
 
-	if right == 0 {
-		if left == 0 {
+	if rightNum == 0 {
+		if leftNum == 0 {
 			context.result = Number(math.NaN())
-		} else if left > 0 {
+		} else if leftNum > 0 {
 			context.result = Number(math.Inf(1))
 		} else {
 			context.result = Number(math.Inf(-1))
@@ -78,35 +60,50 @@ func execMultiplicativeExprDivide(context *exprContext, expr *grammar.Grammar) e
 		return nil
 	}
 
-	context.result = Number(left / right)
+	context.result = Number(leftNum / rightNum)
 	return nil
 }
 
-func execMultiplicativeExprMod(context *exprContext, expr *grammar.Grammar) error {
-	left, right, err := leftRightIndependentNumber(context, expr)
-
-	if err != nil {
-		return err
-	}
+func execMultiplicativeExprMod(context *exprContext, left, right Result) error {
+	leftNum := left.Number()
+	rightNum := right.Number()
 
-	if right == 0 {
+	if rightNum == 0 {
 		context.result = Number(math.NaN())
 		return nil
 	}
 
-	context.result = Number(int(left) % int(right))
+	context.result = Number(int(leftNum) % int(rightNum))
 	return nil
 }
 
-func execUnaryExprNegate(context *exprContext, expr *grammar.Grammar) error {
-	left, err := leftOnlyIndependentResult(context, expr)
+type negateExpr struct {
+	exprSpan
+	operand exprNode
+}
+
+func (e *negateExpr) exec(context *exprContext) error {
+	operand, err := execIndependent(context, e.operand)
 
 	if err != nil {
 		return err
 	}
 
-	leftNum := left.Number()
-
-	context.result = Number(-leftNum)
+	context.result = Number(-operand.Number())
 	return nil
 }
+
+func (e *negateExpr) explain(x *explainer) {
+	x.line("Negate")
+	x.children(e.operand)
+}
+
+func compileUnaryExprNegate(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	operand, err := c.compileChildren(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &negateExpr{operand: operand}, nil
+}
@@ -2,509 +2,1175 @@ package exec
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/ChrisTrenkamp/xsel/grammar"
-	"github.com/ChrisTrenkamp/xsel/grammar/parser/bsr"
 	"github.com/ChrisTrenkamp/xsel/grammar/parser/symbols"
 	"github.com/ChrisTrenkamp/xsel/node"
+	"github.com/ChrisTrenkamp/xsel/store"
 )
 
-var errQueryNonNodeset = fmt.Errorf("cannot query nodes on non-NodeSet's")
-
 func init() {
-	contextFunctions[symbols.NT_AbsoluteLocationPathOnly] = execAbsoluteLocationPathOnly
-	contextFunctions[symbols.NT_RelativeLocationPathWithStep] = leftRightDependentResult
-	contextFunctions[symbols.NT_Step] = execStep
-	contextFunctions[symbols.NT_NodeTestAndPredicate] = leftRightDependentResult
-	contextFunctions[symbols.NT_Predicate] = execPredicate
-	contextFunctions[symbols.NT_NodeTestNodeTypeNoArgTest] = execNodeTestNodeTypeNoArgTest
-	contextFunctions[symbols.NT_NodeTestProcInstTargetTest] = execNodeTestProcInstTargetTest
-	contextFunctions[symbols.NT_NameTestAnyElement] = execNameTestAnyElement
-	contextFunctions[symbols.NT_NameTestNamespaceAnyLocal] = execNameTestNamespaceAnyLocal
-	contextFunctions[symbols.NT_NameTestNamespaceAnyLocalReservedNameConflict] = execNameTestNamespaceAnyLocalReservedNameConflict
-	contextFunctions[symbols.NT_NameTestLocalAnyNamespace] = execNameTestLocalAnyNamespace
-	contextFunctions[symbols.NT_NameTestLocalAnyNamespaceReservedNameConflict] = execNameTestLocalAnyNamespaceReservedNameConflict
-	contextFunctions[symbols.NT_NameTestQNameNamespaceWithLocal] = execNameTestQNameNamespaceWithLocal
-	contextFunctions[symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictNamespace] = execNameTestQNameNamespaceWithLocalReservedNameConflictNamespace
-	contextFunctions[symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictLocal] = execNameTestQNameNamespaceWithLocalReservedNameConflictLocal
-	contextFunctions[symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictBoth] = execNameTestQNameNamespaceWithLocalReservedNameConflictBoth
-	contextFunctions[symbols.NT_NameTestQNameLocalOnly] = execNameTestQNameLocalOnly
-	contextFunctions[symbols.NT_NameTestQNameLocalOnlyReservedNameConflict] = execNameTestQNameLocalOnly
-	contextFunctions[symbols.NT_StepWithAxisAndNodeTest] = leftRightDependentResult
-	contextFunctions[symbols.NT_StepWithAxisAndNodeTestAndPredicate] = leftRightDependentResult
-	contextFunctions[symbols.NT_StepWithPredicateWithAnotherPredicate] = leftRightDependentResult
-	contextFunctions[symbols.NT_FilterExprWithPredicate] = leftRightDependentResult
-	contextFunctions[symbols.NT_AxisName] = execAxisName
-	contextFunctions[symbols.NT_AbbreviatedStepParent] = execAbbreviatedStepParent
-	contextFunctions[symbols.NT_AbbreviatedAxisSpecifier] = execAbbreviatedAxisSpecifier
-	contextFunctions[symbols.NT_AbbreviatedAbsoluteLocationPath] = execAbbreviatedAbsoluteLocationPath
-	contextFunctions[symbols.NT_AbbreviatedRelativeLocationPath] = execAbbreviatedRelativeLocationPath
-}
-
-func execAbsoluteLocationPathOnly(context *exprContext, expr *grammar.Grammar) error {
-	context.result = NodeSet{context.root}
+	compileFunctions[symbols.NT_AbsoluteLocationPathOnly] = compileAbsoluteLocationPathOnly
+	compileFunctions[symbols.NT_AbsoluteLocationPathWithRelative] = compileAbsoluteLocationPathWithRelative
+	compileFunctions[symbols.NT_AbbreviatedAbsoluteLocationPath] = compileAbbreviatedAbsoluteLocationPath
+	compileFunctions[symbols.NT_RelativeLocationPathWithStep] = compilePathWithStep
+	compileFunctions[symbols.NT_AbbreviatedRelativeLocationPath] = compileAbbreviatedPathWithStep
+	compileFunctions[symbols.NT_PathExprFilterWithPath] = compilePathWithStep
+	compileFunctions[symbols.NT_PathExprFilterWithAbbreviatedPath] = compileAbbreviatedPathWithStep
+	compileFunctions[symbols.NT_RelativeLocationPathWithExprStep] = compilePathWithExprStep
+	compileFunctions[symbols.NT_PathExprFilterWithExprStep] = compilePathWithExprStep
+	compileFunctions[symbols.NT_NodeTestAndPredicate] = compileStepWithPredicate
+	compileFunctions[symbols.NT_StepWithAxisAndNodeTestAndPredicate] = compileStepWithPredicate
+	compileFunctions[symbols.NT_StepWithAxisAndNodeTest] = compileStepWithAxisAndNodeTest
+	compileFunctions[symbols.NT_FilterExprWithPredicate] = compileFilterExprWithPredicate
+	compileFunctions[symbols.NT_Predicate] = compilePredicate
+	compileFunctions[symbols.NT_AbbreviatedStepSelf] = compileAbbreviatedStepSelf
+	compileFunctions[symbols.NT_AbbreviatedStepParent] = compileAbbreviatedStepParent
+	compileFunctions[symbols.NT_NodeTestNodeTypeNoArgTest] = compileNodeTestNodeTypeNoArgTest
+	compileFunctions[symbols.NT_NodeTestProcInstTargetTest] = compileNodeTestProcInstTargetTest
+	compileFunctions[symbols.NT_NameTestAnyElement] = compileNameTestAnyElement
+	compileFunctions[symbols.NT_NameTestNamespaceAnyLocal] = compileNameTestNamespaceAnyLocal
+	compileFunctions[symbols.NT_NameTestNamespaceAnyLocalReservedNameConflict] = compileNameTestNamespaceAnyLocalReservedNameConflict
+	compileFunctions[symbols.NT_NameTestLocalAnyNamespace] = compileNameTestLocalAnyNamespace
+	compileFunctions[symbols.NT_NameTestLocalAnyNamespaceReservedNameConflict] = compileNameTestLocalAnyNamespaceReservedNameConflict
+	compileFunctions[symbols.NT_NameTestQNameNamespaceWithLocal] = compileNameTestQNameNamespaceWithLocal
+	compileFunctions[symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictNamespace] = compileNameTestQNameNamespaceWithLocalReservedNameConflictNamespace
+	compileFunctions[symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictLocal] = compileNameTestQNameNamespaceWithLocalReservedNameConflictLocal
+	compileFunctions[symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictBoth] = compileNameTestQNameNamespaceWithLocalReservedNameConflictBoth
+	compileFunctions[symbols.NT_NameTestQNameLocalOnly] = compileNameTestQNameLocalOnly
+	compileFunctions[symbols.NT_NameTestQNameLocalOnlyReservedNameConflict] = compileNameTestQNameLocalOnly
+}
+
+// pathExpr evaluates each of its steps in order, with each step operating on
+// the result of the previous one.
+type pathExpr struct {
+	exprSpan
+	steps []exprNode
+}
+
+func (e *pathExpr) exec(context *exprContext) error {
+	if context.streaming() && e.streams() {
+		return execStream(context, e)
+	}
+
+	for _, i := range e.steps {
+		if err := execContext(context, i); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func execStep(context *exprContext, expr *grammar.Grammar) error {
-	var nextBsr *bsr.BSR
+// streams reports whether every step after the first selects its nodes from
+// a stream.  Mid-path function calls need the whole NodeSet.
+func (e *pathExpr) streams() bool {
+	for _, i := range e.steps[1:] {
+		if _, ok := i.(pipeExpr); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e *pathExpr) stream(context *exprContext) (nodeStream, error) {
+	ret, err := streamContext(context, e.steps[0])
+
+	if err != nil {
+		return nodeStream{}, err
+	}
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			nextBsr = &c
-			break
+	for _, i := range e.steps[1:] {
+		ret, err = pipeContext(context, i.(pipeExpr), ret)
+
+		if err != nil {
+			return nodeStream{}, err
 		}
 	}
 
-	switch nextBsr.Label.Slot().NT {
-	case symbols.NT_NodeTest,
-		symbols.NT_NodeTestAndPredicate,
-		symbols.NT_NodeTestNodeTypeNoArgTest,
-		symbols.NT_NodeTestProcInstTargetTest,
-		symbols.NT_NameTestAnyElement,
-		symbols.NT_NameTestNamespaceAnyLocal,
-		symbols.NT_NameTestNamespaceAnyLocalReservedNameConflict,
-		symbols.NT_NameTestLocalAnyNamespace,
-		symbols.NT_NameTestLocalAnyNamespaceReservedNameConflict,
-		symbols.NT_NameTestQNameNamespaceWithLocal,
-		symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictNamespace,
-		symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictLocal,
-		symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictBoth,
-		symbols.NT_NameTestQNameLocalOnly,
-		symbols.NT_NameTestQNameLocalOnlyReservedNameConflict:
-		nodeSet, ok := context.result.(NodeSet)
+	return ret, nil
+}
 
-		if !ok {
-			return errQueryNonNodeset
+func (e *pathExpr) explain(x *explainer) {
+	// A function call anywhere but the start of a path is an xsel extension.
+	for _, i := range e.steps[1:] {
+		if fn, ok := i.(*functionCallExpr); ok {
+			x.extension("mid-path function call: %s()", fn.name)
+		}
+	}
+
+	x.line("Path")
+	x.children(e.steps...)
+}
+
+func appendSteps(steps []exprNode, step exprNode) []exprNode {
+	if path, ok := step.(*pathExpr); ok {
+		for _, i := range path.steps {
+			steps = appendSteps(steps, i)
+		}
+
+		return steps
+	}
+
+	if len(steps) > 0 {
+		if descendant := fuseDescendantStep(steps[len(steps)-1], step); descendant != nil {
+			steps[len(steps)-1] = descendant
+			return steps
 		}
+	}
+
+	return append(steps, step)
+}
+
+// fuseDescendantStep rewrites "descendant-or-self::node()/child::name" (what
+// "//name" abbreviates) into "descendant::name", so the step can use the
+// store's name index.  Since predicates are applied to the whole result of a
+// step, rather than to each context node's, the two select the same nodes in
+// the same positions.  It returns nil if the steps cannot be fused.
+func fuseDescendantStep(prev, next exprNode) *stepExpr {
+	prevStep, ok := prev.(*stepExpr)
+
+	if !ok || prevStep.axis != axisDescendantOrSelf || prevStep.test.kind != nodeTestNode || len(prevStep.predicates) > 0 {
+		return nil
+	}
+
+	nextStep, ok := next.(*stepExpr)
+
+	if !ok || nextStep.axis != axisChild || !nextStep.test.isName() {
+		return nil
+	}
+
+	span := nextStep.span
+
+	if prevStep.span.End == span.Start {
+		span.Start = prevStep.span.Start
+		span.Text = prevStep.span.Text + span.Text
+	}
+
+	return &stepExpr{
+		exprSpan:   exprSpan{span},
+		axis:       axisDescendant,
+		test:       nextStep.test,
+		predicates: nextStep.predicates,
+	}
+}
+
+func newPath(steps ...exprNode) *pathExpr {
+	ret := &pathExpr{
+		steps: make([]exprNode, 0, len(steps)),
+	}
 
-		context.result = selectChild(nodeSet)
+	for _, i := range steps {
+		ret.steps = appendSteps(ret.steps, i)
 	}
 
-	return execContext(context, expr.Next(nextBsr))
+	return ret
 }
 
-func execPredicate(context *exprContext, expr *grammar.Grammar) error {
+// rootExpr selects the root of the document.
+type rootExpr struct {
+	exprSpan
+}
+
+func (rootExpr) exec(context *exprContext) error {
+	context.result = NodeSet{context.root}
+	return nil
+}
+
+func (rootExpr) pipe(context *exprContext, input nodeStream) (nodeStream, error) {
+	return nodeSetStream(NodeSet{context.root}), nil
+}
+
+func (rootExpr) explain(x *explainer) {
+	x.line("Root")
+}
+
+// descendantOrSelfStep is the step the "//" abbreviation at span stands for.
+func descendantOrSelfStep(span Span) *stepExpr {
+	return &stepExpr{
+		exprSpan: exprSpan{span},
+		axis:     axisDescendantOrSelf,
+		test:     nodeTest{kind: nodeTestNode},
+	}
+}
+
+func compileAbsoluteLocationPathOnly(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	return &rootExpr{}, nil
+}
+
+func compileAbsoluteLocationPathWithRelative(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	relative, err := c.compileChildren(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	root := &rootExpr{}
+	root.span = tokenSpan(expr.BSR.GetTChildI(0))
+
+	return newPath(root, relative), nil
+}
+
+func compileAbbreviatedAbsoluteLocationPath(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	relative, err := c.compileChildren(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	span := tokenSpan(expr.BSR.GetTChildI(0))
+	root := &rootExpr{}
+	root.span = span
+
+	return newPath(root, descendantOrSelfStep(span), relative), nil
+}
+
+func compilePathWithStep(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	left, right, err := c.compileLeftRight(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newPath(left, right), nil
+}
+
+func compileAbbreviatedPathWithStep(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	left, right, err := c.compileLeftRight(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	span := tokenSpan(expr.BSR.GetTChildI(1))
+
+	return newPath(left, descendantOrSelfStep(span), right), nil
+}
+
+// exprStepExpr is a parenthesised expression used as a step, such as the last
+// step of "line/(@qty * @price)".  The expression is evaluated once for each
+// node selected by the previous steps, and the items it returns are joined
+// together.  If they are all nodes, they are returned in document order,
+// without duplicates.
+type exprStepExpr struct {
+	exprSpan
+	expr exprNode
+}
+
+func (e *exprStepExpr) exec(context *exprContext) error {
 	nodeSet, ok := context.result.(NodeSet)
 
 	if !ok {
-		return errQueryNonNodeset
+		return ErrNotNodeSet
 	}
 
-	nextResult := make(NodeSet, 0)
+	ret := make(Sequence, 0, len(nodeSet))
+	size := func() int { return len(nodeSet) }
 
-	for i := range nodeSet {
-		nextContext := context.copy()
-		nextContext.result = NodeSet{nodeSet[i]}
-		nextContext.contextPosition = i
-		left, err := leftOnlyIndependentResult(&nextContext, expr)
+	for i, n := range nodeSet {
+		next := context.copy()
+		next.result = NodeSet{n}
+		next.contextPosition = i
+		next.sizer = size
 
-		if err != nil {
+		if err := execContext(&next, e.expr); err != nil {
 			return err
 		}
 
-		if n, ok := left.(Number); ok {
-			if (i + 1) == int(n) {
-				nextResult = append(nextResult, nodeSet[i])
-			}
-		} else if b, ok := left.(Bool); ok {
-			if bool(b) {
-				nextResult = append(nextResult, nodeSet[i])
-			}
-		} else if left.Bool() {
-			nextResult = append(nextResult, nodeSet[i])
+		ret = append(ret, Items(next.result)...)
+
+		if err := context.checkSize(len(ret)); err != nil {
+			return err
 		}
 	}
 
-	context.result = nextResult
+	context.result = sequenceOf(ret)
 	return nil
 }
 
-func execNodeTestNodeTypeNoArgTest(context *exprContext, expr *grammar.Grammar) error {
-	nodeSet, ok := context.result.(NodeSet)
+func (e *exprStepExpr) explain(x *explainer) {
+	x.line("Expression step")
+	x.children(e.expr)
+	x.extension("parenthesised expression step")
+}
 
-	if !ok {
-		return nil
+func compilePathWithExprStep(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	left, right, err := c.compileLeftRight(expr)
+
+	if err != nil {
+		return nil, err
 	}
 
-	nodeType := expr.GetString()
-	parenIndex := strings.LastIndex(nodeType, "(")
-	nodeType = nodeType[:parenIndex]
-	nodeType = strings.TrimSpace(nodeType)
+	step := &exprStepExpr{expr: right}
+	step.span = *right.spanOf()
 
-	result := make(NodeSet, 0)
+	return newPath(left, step), nil
+}
 
-	switch nodeType {
-	case "comment":
-		for _, i := range nodeSet {
-			if _, ok := i.Node().(node.Comment); ok {
-				result = append(result, i)
-			}
+// stepExpr selects the nodes along an axis that match a node test, and then
+// filters them through its predicates.
+type stepExpr struct {
+	exprSpan
+	axis       axis
+	test       nodeTest
+	predicates []exprNode
+}
+
+func (e *stepExpr) exec(context *exprContext) error {
+	nodeSet, ok := context.result.(NodeSet)
+
+	if !ok {
+		// "." is the context item, even if it is a string, number or
+		// boolean in a predicate over a Sequence.
+		if e.axis == axisSelf && e.test.kind == nodeTestNode && len(e.predicates) == 0 {
+			return nil
 		}
-	case "text":
-		for _, i := range nodeSet {
-			if _, ok := i.Node().(node.CharData); ok {
-				result = append(result, i)
-			}
+
+		return ErrNotNodeSet
+	}
+
+	if context.streaming() {
+		stream, err := e.pipe(context, nodeSetStream(nodeSet))
+
+		if err != nil {
+			return err
 		}
-	case "processing-instruction":
-		for _, i := range nodeSet {
-			if _, ok := i.Node().(node.ProcInst); ok {
-				result = append(result, i)
-			}
+
+		context.result, err = collect(context, stream)
+		return err
+	}
+
+	selected := e.selectNodes(nodeSet)
+
+	if err := context.visitNodes(len(selected)); err != nil {
+		return err
+	}
+
+	if err := context.checkNodeSetSize(selected); err != nil {
+		return err
+	}
+
+	context.result = e.test.filter(selected)
+
+	for _, i := range e.predicates {
+		if err := execContext(context, i); err != nil {
+			return err
 		}
-	case "node":
-		return nil
 	}
 
-	context.result = result
 	return nil
 }
 
-func execNodeTestProcInstTargetTest(context *exprContext, expr *grammar.Grammar) error {
-	nodeSet, ok := context.result.(NodeSet)
+func (e *stepExpr) pipe(context *exprContext, input nodeStream) (nodeStream, error) {
+	ret, err := e.streamAxis(context, input)
 
-	if !ok {
-		return nil
+	if err != nil {
+		return nodeStream{}, err
+	}
+
+	if e.test.kind != nodeTestNode {
+		ret.nodeIterator = &testIterator{input: ret.nodeIterator, test: &e.test}
+	}
+
+	for _, i := range e.predicates {
+		ret, err = pipeContext(context, i.(pipeExpr), ret)
+
+		if err != nil {
+			return nodeStream{}, err
+		}
 	}
 
-	literal, err := leftOnlyIndependentResult(context, expr)
+	return ret, nil
+}
+
+func (e *stepExpr) explain(x *explainer) {
+	x.line("Step %s::%s", e.axis, e.test.explain(x))
+	x.children(e.predicates...)
+}
+
+func (e *stepExpr) selectNodes(nodeSet NodeSet) NodeSet {
+	if e.test.isName() {
+		switch e.axis {
+		case axisDescendant:
+			return selectDescendantNamed(nodeSet, e.test.space, e.test.local)
+		case axisFollowing:
+			return selectFollowingNamed(nodeSet, e.test.space, e.test.local)
+		}
+	}
+
+	return e.axis.selectNodes(nodeSet)
+}
+
+func (c *compiler) compileStep(expr *grammar.Grammar) (*stepExpr, error) {
+	step, err := c.compile(expr)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	literalString := literal.String()
-	result := make(NodeSet, 0)
+	return step.(*stepExpr), nil
+}
 
-	for _, i := range nodeSet {
-		if pi, ok := i.Node().(node.ProcInst); ok && pi.Target() == literalString {
-			result = append(result, i)
+func (c *compiler) compilePredicates(expr *grammar.Grammar, predicates *[]exprNode) error {
+	for _, i := range ntChildren(expr) {
+		if i.BSR.Label.Slot().NT != symbols.NT_Predicate {
+			if err := c.compilePredicates(i, predicates); err != nil {
+				return err
+			}
+
+			continue
 		}
+
+		predicate, err := c.compile(i)
+
+		if err != nil {
+			return err
+		}
+
+		*predicates = append(*predicates, predicate)
 	}
 
-	context.result = result
 	return nil
 }
 
-func execNameTestAnyElement(context *exprContext, expr *grammar.Grammar) error {
-	nodeSet, ok := context.result.(NodeSet)
+func compileStepWithPredicate(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
+	step, err := c.compileStep(children[0])
 
-	if !ok {
-		return nil
+	if err != nil {
+		return nil, err
 	}
 
-	result := make(NodeSet, 0)
+	if err := c.compilePredicates(children[1], &step.predicates); err != nil {
+		return nil, err
+	}
 
-	for _, i := range nodeSet {
-		if _, ok := i.Node().(node.NamedNode); ok {
-			result = append(result, i)
+	step.span = grammarSpan(expr)
+	return step, nil
+}
+
+func compileStepWithAxisAndNodeTest(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
+	step, err := c.compileStep(children[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	step.axis = compileAxis(children[0])
+	step.span = grammarSpan(expr)
+	return step, nil
+}
+
+// filterExpr filters the result of a primary expression through its predicates.
+type filterExpr struct {
+	exprSpan
+	primary    exprNode
+	predicates []exprNode
+}
+
+func (e *filterExpr) exec(context *exprContext) error {
+	if context.streaming() {
+		stream, items, err := e.open(context)
+
+		if err != nil {
+			return err
 		}
 
-		if _, ok := i.Node().(node.Namespace); ok {
-			result = append(result, i)
+		if items != nil {
+			context.result = items
+			return nil
+		}
+
+		nodeSet, err := collect(context, stream)
+
+		if err != nil {
+			return err
+		}
+
+		context.result = nodeSet
+		return nil
+	}
+
+	if err := execContext(context, e.primary); err != nil {
+		return err
+	}
+
+	for _, i := range e.predicates {
+		if err := execContext(context, i); err != nil {
+			return err
 		}
 	}
 
-	context.result = result
 	return nil
 }
 
-func execNameTestNamespaceAnyLocal(context *exprContext, expr *grammar.Grammar) error {
-	namespaceLookup := expr.BSR.GetTChildI(0).LiteralString()
+// streams reports whether the primary expression can be streamed.  Anything
+// else may return a Sequence, which has to be filtered in full.
+func (e *filterExpr) streams() bool {
+	switch primary := e.primary.(type) {
+	case pipeExpr:
+		return true
+	case streamer:
+		return primary.streams()
+	}
+
+	return false
+}
+
+func (e *filterExpr) stream(context *exprContext) (nodeStream, error) {
+	stream, items, err := e.open(context)
+
+	if err != nil || items == nil {
+		return stream, err
+	}
 
-	return nameTestNamespaceAnyLocal(namespaceLookup, context, expr)
+	return resultStream(items)
 }
 
-func execNameTestNamespaceAnyLocalReservedNameConflict(context *exprContext, expr *grammar.Grammar) error {
-	children := make([]*bsr.BSR, 0, 1)
+// open streams the nodes of the primary expression through the predicates.
+// If the primary expression does not return a NodeSet, its items are filtered
+// in full, and returned instead of a stream.
+func (e *filterExpr) open(context *exprContext) (nodeStream, Result, error) {
+	var ret nodeStream
+
+	if streams(context, e.primary) {
+		stream, err := streamContext(context, e.primary)
+
+		if err != nil {
+			return nodeStream{}, nil, err
+		}
+
+		ret = stream
+	} else {
+		primary, err := execIndependent(context, e.primary)
+
+		if err != nil {
+			return nodeStream{}, nil, err
+		}
+
+		nodeSet, ok := primary.(NodeSet)
+
+		if !ok {
+			items := context.copy()
+			items.result = primary
+
+			for _, i := range e.predicates {
+				if err := execContext(&items, i); err != nil {
+					return nodeStream{}, nil, err
+				}
+			}
+
+			return nodeStream{}, items.result, nil
+		}
+
+		ret = nodeSetStream(nodeSet)
+	}
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			children = append(children, &c)
+	for _, i := range e.predicates {
+		stream, err := pipeContext(context, i.(pipeExpr), ret)
+
+		if err != nil {
+			return nodeStream{}, nil, err
 		}
+
+		ret = stream
 	}
 
-	namespaceLookup := expr.GetStringExtents(children[0].LeftExtent(), children[0].RightExtent())
-	return nameTestNamespaceAnyLocal(namespaceLookup, context, expr)
+	return ret, nil, nil
 }
 
-func nameTestNamespaceAnyLocal(namespaceLookup string, context *exprContext, expr *grammar.Grammar) error {
-	namespaceValue, ok := context.NamespaceDecls[namespaceLookup]
+func (e *filterExpr) explain(x *explainer) {
+	x.line("Filter")
+	x.children(e.primary)
+	x.children(e.predicates...)
+}
+
+func compileFilterExprWithPredicate(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	primary, predicate, err := c.compileLeftRight(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	filter, ok := primary.(*filterExpr)
 
 	if !ok {
-		return fmt.Errorf("unknown namespace binding '%s'", namespaceLookup)
+		filter = &filterExpr{primary: primary}
 	}
 
+	filter.predicates = append(filter.predicates, predicate)
+	filter.span = grammarSpan(expr)
+	return filter, nil
+}
+
+type predicateExpr struct {
+	exprSpan
+	expr exprNode
+	// position is set when expr is a constant position, such as [1], so
+	// nodes past it need not be looked at.
+	position int
+}
+
+func (e *predicateExpr) exec(context *exprContext) error {
 	nodeSet, ok := context.result.(NodeSet)
 
 	if !ok {
-		return nil
+		return e.filterItems(context, Items(context.result))
 	}
 
-	result := make(NodeSet, 0)
+	nextResult := make(NodeSet, 0)
+	size := func() int { return len(nodeSet) }
 
-	for _, i := range nodeSet {
-		if node, ok := i.Node().(node.NamedNode); ok {
-			if node.Space() == namespaceValue {
-				result = append(result, i)
-			}
+	for i := range nodeSet {
+		matches, err := e.matches(context, NodeSet{nodeSet[i]}, i, size)
+
+		if err != nil {
+			return err
+		}
+
+		if matches {
+			nextResult = append(nextResult, nodeSet[i])
 		}
 	}
 
-	context.result = result
+	context.result = nextResult
 	return nil
 }
 
-func execNameTestLocalAnyNamespace(context *exprContext, expr *grammar.Grammar) error {
-	localValue := expr.BSR.GetTChildI(2).LiteralString()
+// filterItems filters the items of a Sequence.  Strings, numbers and booleans
+// are the context item of the predicate, as nodes are.
+func (e *predicateExpr) filterItems(context *exprContext, items Sequence) error {
+	nextResult := make(Sequence, 0)
+	size := func() int { return len(items) }
+
+	for i, item := range items {
+		matches, err := e.matches(context, item, i, size)
+
+		if err != nil {
+			return err
+		}
+
+		if matches {
+			nextResult = append(nextResult, item)
+		}
+	}
 
-	return nameTestLocalAnyNamespace(localValue, context, expr)
+	context.result = sequenceOf(nextResult)
+	return nil
 }
 
-func execNameTestLocalAnyNamespaceReservedNameConflict(context *exprContext, expr *grammar.Grammar) error {
-	children := make([]*bsr.BSR, 0, 1)
+// matches evaluates the predicate against the item at position i.  size
+// counts the items being filtered.
+func (e *predicateExpr) matches(context *exprContext, item Result, i int, size func() int) (bool, error) {
+	nextContext := context.copy()
+	nextContext.result = item
+	nextContext.contextPosition = i
+	nextContext.sizer = size
+
+	if streams(&nextContext, e.expr) {
+		return execBool(&nextContext, e.expr)
+	}
+
+	left, err := execIndependent(&nextContext, e.expr)
+
+	if err != nil {
+		return false, err
+	}
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			children = append(children, &c)
-			break
+	// A sequence of one number is a position, like the number itself.
+	if sequence, ok := left.(Sequence); ok {
+		if items := Items(sequence); len(items) == 1 {
+			left = items[0]
 		}
 	}
 
-	localValue := expr.GetStringExtents(children[0].LeftExtent(), children[0].RightExtent())
-	return nameTestLocalAnyNamespace(localValue, context, expr)
+	if n, ok := left.(Number); ok {
+		return (i + 1) == int(n), nil
+	}
+
+	return effectiveBool(left)
 }
 
-func nameTestLocalAnyNamespace(localValue string, context *exprContext, expr *grammar.Grammar) error {
-	nodeSet, ok := context.result.(NodeSet)
+func (e *predicateExpr) pipe(context *exprContext, input nodeStream) (nodeStream, error) {
+	input.nodeIterator = &predicateIterator{
+		input:     input.nodeIterator,
+		predicate: e,
+		context:   context,
+		size:      -1,
+	}
 
-	if !ok {
-		return nil
+	return input, nil
+}
+
+type predicateIterator struct {
+	input     nodeIterator
+	predicate *predicateExpr
+	context   *exprContext
+	i         int
+	// size is the number of input nodes, or -1 if they have not been counted.
+	size int
+	err  error
+}
+
+func (p *predicateIterator) next() (store.Cursor, error) {
+	if p.err != nil {
+		return nil, p.err
 	}
 
-	result := make(NodeSet, 0)
+	for p.predicate.position == 0 || p.i < p.predicate.position {
+		next, err := p.input.next()
 
-	for _, i := range nodeSet {
-		if node, ok := i.Node().(node.NamedNode); ok {
-			if node.Local() == localValue {
-				result = append(result, i)
+		if next == nil || err != nil {
+			return nil, err
+		}
+
+		i := p.i
+		p.i++
+
+		if p.predicate.position > 0 {
+			if p.i == p.predicate.position {
+				return next, nil
 			}
+
+			continue
+		}
+
+		matches, err := p.predicate.matches(p.context, NodeSet{next}, i, p.count)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if p.err != nil {
+			return nil, p.err
+		}
+
+		if matches {
+			return next, nil
 		}
 	}
 
-	context.result = result
-	return nil
+	return nil, nil
 }
 
-func execNameTestQNameNamespaceWithLocal(context *exprContext, expr *grammar.Grammar) error {
-	namespaceLookup := expr.BSR.GetTChildI(0).LiteralString()
-	local := expr.BSR.GetTChildI(2).LiteralString()
+// count reads the rest of the input, so the context size is known.  The
+// context nodes are limited like any other NodeSet, and an error reading them
+// is returned by the next call to next.
+func (p *predicateIterator) count() int {
+	if p.size < 0 {
+		rest, err := collect(p.context, p.input)
+		p.input = &sliceIterator{nodes: rest}
+		p.size = p.i + len(rest)
+
+		if err == nil {
+			err = p.context.checkSize(p.size)
+		}
+
+		p.err = err
+	}
+
+	return p.size
+}
 
-	return nameTestQNameNamespaceWithLocal(namespaceLookup, local, context, expr)
+func (e *predicateExpr) explain(x *explainer) {
+	x.line("Predicate")
+	x.children(e.expr)
 }
 
-func execNameTestQNameNamespaceWithLocalReservedNameConflictNamespace(context *exprContext, expr *grammar.Grammar) error {
-	children := make([]*bsr.BSR, 0, 1)
+func compilePredicate(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	predicate, err := c.compileChildren(expr)
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			children = append(children, &c)
-			break
+	if err != nil {
+		return nil, err
+	}
+
+	if c.check == nil {
+		predicate = hoistPredicate(predicate)
+	}
+
+	ret := &predicateExpr{expr: predicate}
+
+	if literal, ok := predicate.(*literalExpr); ok {
+		if n, ok := literal.value.(Number); ok && n >= 1 && n <= math.MaxInt32 {
+			ret.position = int(n)
 		}
 	}
 
-	namespaceLookup := expr.GetStringExtents(children[0].LeftExtent(), children[0].RightExtent())
-	local := expr.BSR.GetTChildI(2).LiteralString()
-	return nameTestQNameNamespaceWithLocal(namespaceLookup, local, context, expr)
+	return ret, nil
 }
 
-func execNameTestQNameNamespaceWithLocalReservedNameConflictLocal(context *exprContext, expr *grammar.Grammar) error {
-	children := make([]*bsr.BSR, 0, 1)
+func compileAbbreviatedStepSelf(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	return &stepExpr{
+		axis: axisSelf,
+		test: nodeTest{kind: nodeTestNode},
+	}, nil
+}
+
+func compileAbbreviatedStepParent(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	return &stepExpr{
+		axis: axisParent,
+		test: nodeTest{kind: nodeTestNode},
+	}, nil
+}
+
+type axis int
+
+const (
+	axisChild axis = iota
+	axisAttribute
+	axisAncestor
+	axisAncestorOrSelf
+	axisDescendant
+	axisDescendantOrSelf
+	axisFollowing
+	axisFollowingSibling
+	axisNamespace
+	axisParent
+	axisPreceding
+	axisPrecedingSibling
+	axisSelf
+)
+
+var axisNames = map[string]axis{
+	"child":              axisChild,
+	"attribute":          axisAttribute,
+	"ancestor":           axisAncestor,
+	"ancestor-or-self":   axisAncestorOrSelf,
+	"descendant":         axisDescendant,
+	"descendant-or-self": axisDescendantOrSelf,
+	"following":          axisFollowing,
+	"following-sibling":  axisFollowingSibling,
+	"namespace":          axisNamespace,
+	"parent":             axisParent,
+	"preceding":          axisPreceding,
+	"preceding-sibling":  axisPrecedingSibling,
+	"self":               axisSelf,
+}
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			children = append(children, &c)
-			break
+func (a axis) String() string {
+	for name, i := range axisNames {
+		if i == a {
+			return name
 		}
 	}
 
-	namespaceLookup := expr.BSR.GetTChildI(0).LiteralString()
-	local := expr.GetStringExtents(children[0].LeftExtent(), children[0].RightExtent())
-	return nameTestQNameNamespaceWithLocal(namespaceLookup, local, context, expr)
+	return "unknown"
+}
+
+func (a axis) selectNodes(nodeSet NodeSet) NodeSet {
+	switch a {
+	case axisChild:
+		return selectChild(nodeSet)
+	case axisAttribute:
+		return selectAttributes(nodeSet)
+	case axisAncestor:
+		return selectAncestor(nodeSet)
+	case axisAncestorOrSelf:
+		return selectAncestorOrSelf(nodeSet)
+	case axisDescendant:
+		return selectDescendant(nodeSet)
+	case axisDescendantOrSelf:
+		return selectDescendantOrSelf(nodeSet)
+	case axisFollowing:
+		return selectFollowing(nodeSet)
+	case axisFollowingSibling:
+		return selectFollowingSibling(nodeSet)
+	case axisNamespace:
+		return selectNamespace(nodeSet)
+	case axisParent:
+		return selectParent(nodeSet)
+	case axisPreceding:
+		return selectPreceding(nodeSet)
+	case axisPrecedingSibling:
+		return selectPrecedingSibling(nodeSet)
+	}
+
+	return nodeSet
+}
+
+func compileAxis(expr *grammar.Grammar) axis {
+	switch expr.BSR.Label.Slot().NT {
+	case symbols.NT_AbbreviatedAxisSpecifier:
+		return axisAttribute
+	case symbols.NT_AxisName:
+		return axisNames[strings.TrimSpace(expr.GetString())]
+	}
+
+	return compileAxis(ntChildren(expr)[0])
+}
+
+type nodeTestKind int
+
+const (
+	nodeTestNode nodeTestKind = iota
+	nodeTestText
+	nodeTestComment
+	nodeTestProcInst
+	nodeTestProcInstTarget
+	nodeTestAnyName
+	nodeTestNamespaceAnyLocal
+	nodeTestLocalAnyNamespace
+	nodeTestQName
+	nodeTestLocal
+)
+
+// nodeTest is a node test with its namespace prefixes already resolved.
+type nodeTest struct {
+	kind  nodeTestKind
+	space string
+	local string
+	// For unprefixed name tests on the namespace axis, this is the namespace
+	// bound to the local name.
+	namespaceValue string
 }
 
-func execNameTestQNameNamespaceWithLocalReservedNameConflictBoth(context *exprContext, expr *grammar.Grammar) error {
-	children := make([]*bsr.BSR, 0, 2)
+func (t *nodeTest) matches(c store.Cursor) bool {
+	switch t.kind {
+	case nodeTestNode:
+		return true
+	case nodeTestText:
+		_, ok := c.Node().(node.CharData)
+		return ok
+	case nodeTestComment:
+		_, ok := c.Node().(node.Comment)
+		return ok
+	case nodeTestProcInst:
+		_, ok := c.Node().(node.ProcInst)
+		return ok
+	case nodeTestProcInstTarget:
+		pi, ok := c.Node().(node.ProcInst)
+		return ok && pi.Target() == t.local
+	case nodeTestAnyName:
+		_, named := c.Node().(node.NamedNode)
+		_, namespace := c.Node().(node.Namespace)
+		return named || namespace
+	case nodeTestNamespaceAnyLocal:
+		n, ok := c.Node().(node.NamedNode)
+		return ok && n.Space() == t.space
+	case nodeTestLocalAnyNamespace:
+		n, ok := c.Node().(node.NamedNode)
+		return ok && n.Local() == t.local
+	case nodeTestQName:
+		n, ok := c.Node().(node.NamedNode)
+		return ok && n.Local() == t.local && n.Space() == t.space
+	case nodeTestLocal:
+		if n, ok := c.Node().(node.NamedNode); ok {
+			return n.Space() == "" && n.Local() == t.local
+		}
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			children = append(children, &c)
-			break
+		if ns, ok := c.Node().(node.Namespace); ok {
+			return ns.NamespaceValue() == t.namespaceValue
 		}
 	}
 
-	namespaceLookup := expr.GetStringExtents(children[0].LeftExtent(), children[0].RightExtent())
-	local := expr.GetStringExtents(children[1].LeftExtent(), children[1].RightExtent())
-	return nameTestQNameNamespaceWithLocal(namespaceLookup, local, context, expr)
+	return false
 }
 
-func nameTestQNameNamespaceWithLocal(namespaceLookup, local string, context *exprContext, expr *grammar.Grammar) error {
-	namespaceValue, ok := context.NamespaceDecls[namespaceLookup]
+// explain returns the node test as it would be written in a query, with its
+// namespace prefixes replaced by the namespaces they are bound to.  Any xsel
+// extensions the node test relies on are noted with the explainer.
+func (t *nodeTest) explain(x *explainer) string {
+	if t.kind != nodeTestProcInstTarget && strings.Contains(t.local, "#") {
+		x.extension("'#' in name: %s", t.local)
+	}
 
-	if !ok {
-		return fmt.Errorf("unknown namespace binding '%s'", namespaceLookup)
+	switch t.kind {
+	case nodeTestText:
+		return "text()"
+	case nodeTestComment:
+		return "comment()"
+	case nodeTestProcInst:
+		return "processing-instruction()"
+	case nodeTestProcInstTarget:
+		return fmt.Sprintf("processing-instruction(%q)", t.local)
+	case nodeTestAnyName:
+		return "*"
+	case nodeTestNamespaceAnyLocal:
+		return XmlName{t.space, "*"}.String()
+	case nodeTestLocalAnyNamespace:
+		x.extension("namespace wildcard: *:%s", t.local)
+		return "*:" + t.local
+	case nodeTestQName:
+		return XmlName{t.space, t.local}.String()
+	case nodeTestLocal:
+		return t.local
 	}
 
-	nodeSet, ok := context.result.(NodeSet)
+	return "node()"
+}
 
-	if !ok {
-		return nil
+// isName returns true if the node test matches an expanded name.
+func (t *nodeTest) isName() bool {
+	return t.kind == nodeTestQName || t.kind == nodeTestLocal
+}
+
+func (t *nodeTest) filter(nodeSet NodeSet) NodeSet {
+	if t.kind == nodeTestNode {
+		return nodeSet
 	}
 
 	result := make(NodeSet, 0)
 
 	for _, i := range nodeSet {
-		if node, ok := i.Node().(node.NamedNode); ok {
-			if node.Local() == local && node.Space() == namespaceValue {
-				result = append(result, i)
-			}
+		if t.matches(i) {
+			result = append(result, i)
 		}
 	}
 
-	context.result = result
-	return nil
+	return result
 }
 
-func execNameTestQNameLocalOnly(context *exprContext, expr *grammar.Grammar) error {
-	nodeSet, ok := context.result.(NodeSet)
+func childStep(test nodeTest) (exprNode, error) {
+	return &stepExpr{
+		axis: axisChild,
+		test: test,
+	}, nil
+}
+
+func (c *compiler) resolveNamespace(prefix string) (string, error) {
+	namespaceValue, ok := c.namespaces[prefix]
 
 	if !ok {
-		return errQueryNonNodeset
+		return "", c.fail(prefix, errorf(ErrUnknownPrefix, "unknown namespace binding '%s'", prefix))
 	}
 
-	nextResult := make(NodeSet, 0)
-	queryName := expr.GetString()
+	return namespaceValue, nil
+}
 
-	for _, child := range nodeSet {
-		if elem, ok := child.Node().(node.NamedNode); ok {
-			if elem.Space() == "" && elem.Local() == queryName {
-				nextResult = append(nextResult, child)
-			}
-		}
+func compileNodeTestNodeTypeNoArgTest(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	// The NodeType is a single token, so its text is its name.
+	nodeType := ntChildren(expr)[0].GetString()
 
-		if ns, ok := child.Node().(node.Namespace); ok {
-			namespaceValue := context.NamespaceDecls[queryName]
+	switch nodeType {
+	case "comment":
+		return childStep(nodeTest{kind: nodeTestComment})
+	case "text":
+		return childStep(nodeTest{kind: nodeTestText})
+	case "processing-instruction":
+		return childStep(nodeTest{kind: nodeTestProcInst})
+	}
 
-			if ns.NamespaceValue() == namespaceValue {
-				nextResult = append(nextResult, child)
-			}
-		}
+	return childStep(nodeTest{kind: nodeTestNode})
+}
+
+func compileNodeTestProcInstTargetTest(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	literal, err := c.compileChildren(expr)
+
+	if err != nil {
+		return nil, err
 	}
 
-	context.result = nextResult
+	return childStep(nodeTest{
+		kind:  nodeTestProcInstTarget,
+		local: literal.(*literalExpr).value.String(),
+	})
+}
 
-	return execChildren(context, expr)
+func compileNameTestAnyElement(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	return childStep(nodeTest{kind: nodeTestAnyName})
 }
 
-func execAxisName(context *exprContext, expr *grammar.Grammar) error {
-	nodeSet, ok := context.result.(NodeSet)
+func compileNameTestNamespaceAnyLocal(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	namespaceLookup := expr.BSR.GetTChildI(0).LiteralString()
 
-	if !ok {
-		return errQueryNonNodeset
-	}
-
-	axis := expr.GetString()
-	var result Result
-
-	switch axis {
-	case "child":
-		result = selectChild(nodeSet)
-	case "attribute":
-		result = selectAttributes(nodeSet)
-	case "ancestor":
-		result = selectAncestor(nodeSet)
-	case "ancestor-or-self":
-		result = selectAncestorOrSelf(nodeSet)
-	case "descendant":
-		result = selectDescendant(nodeSet)
-	case "descendant-or-self":
-		result = selectDescendantOrSelf(nodeSet)
-	case "following":
-		result = selectFollowing(nodeSet)
-	case "following-sibling":
-		result = selectFollowingSibling(nodeSet)
-	case "namespace":
-		result = selectNamespace(nodeSet)
-	case "parent":
-		result = selectParent(nodeSet)
-	case "preceding":
-		result = selectPreceding(nodeSet)
-	case "preceding-sibling":
-		result = selectPrecedingSibling(nodeSet)
-	default: // self
-		return nil
-	}
+	return nameTestNamespaceAnyLocal(c, namespaceLookup)
+}
 
-	context.result = result
+func compileNameTestNamespaceAnyLocalReservedNameConflict(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
 
-	return nil
+	namespaceLookup := children[0].GetString()
+	return nameTestNamespaceAnyLocal(c, namespaceLookup)
 }
 
-func execAbbreviatedStepParent(context *exprContext, expr *grammar.Grammar) error {
-	nodeSet, ok := context.result.(NodeSet)
+func nameTestNamespaceAnyLocal(c *compiler, namespaceLookup string) (exprNode, error) {
+	namespaceValue, err := c.resolveNamespace(namespaceLookup)
 
-	if !ok {
-		return errQueryNonNodeset
+	if err != nil {
+		return nil, err
 	}
 
-	context.result = selectParent(nodeSet)
-	return nil
+	return childStep(nodeTest{
+		kind:  nodeTestNamespaceAnyLocal,
+		space: namespaceValue,
+	})
 }
 
-func execAbbreviatedAxisSpecifier(context *exprContext, expr *grammar.Grammar) error {
-	nodeSet, ok := context.result.(NodeSet)
+func compileNameTestLocalAnyNamespace(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	localValue := expr.BSR.GetTChildI(2).LiteralString()
 
-	if !ok {
-		return errQueryNonNodeset
-	}
+	return nameTestLocalAnyNamespace(localValue)
+}
 
-	context.result = selectAttributes(nodeSet)
-	return nil
+func compileNameTestLocalAnyNamespaceReservedNameConflict(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
+
+	localValue := children[0].GetString()
+	return nameTestLocalAnyNamespace(localValue)
 }
 
-func execAbbreviatedAbsoluteLocationPath(context *exprContext, expr *grammar.Grammar) error {
-	context.result = selectDescendantOrSelf(NodeSet{context.root})
+func nameTestLocalAnyNamespace(localValue string) (exprNode, error) {
+	return childStep(nodeTest{
+		kind:  nodeTestLocalAnyNamespace,
+		local: localValue,
+	})
+}
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			return execContext(context, expr.Next(&c))
-		}
-	}
+func compileNameTestQNameNamespaceWithLocal(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	namespaceLookup := expr.BSR.GetTChildI(0).LiteralString()
+	local := expr.BSR.GetTChildI(2).LiteralString()
 
-	return nil
+	return nameTestQNameNamespaceWithLocal(c, namespaceLookup, local)
 }
 
-func execAbbreviatedRelativeLocationPath(context *exprContext, expr *grammar.Grammar) error {
-	children := make([]*bsr.BSR, 0, 2)
+func compileNameTestQNameNamespaceWithLocalReservedNameConflictNamespace(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
 
-	for _, cn := range expr.BSR.GetAllNTChildren() {
-		for _, c := range cn {
-			children = append(children, &c)
-		}
-	}
+	namespaceLookup := children[0].GetString()
+	local := expr.BSR.GetTChildI(2).LiteralString()
+	return nameTestQNameNamespaceWithLocal(c, namespaceLookup, local)
+}
 
-	if err := execContext(context, expr.Next(children[0])); err != nil {
-		return err
-	}
+func compileNameTestQNameNamespaceWithLocalReservedNameConflictLocal(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
 
-	nodeSet, ok := context.result.(NodeSet)
+	namespaceLookup := expr.BSR.GetTChildI(0).LiteralString()
+	local := children[0].GetString()
+	return nameTestQNameNamespaceWithLocal(c, namespaceLookup, local)
+}
 
-	if !ok {
-		return errQueryNonNodeset
+func compileNameTestQNameNamespaceWithLocalReservedNameConflictBoth(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
+
+	namespaceLookup := children[0].GetString()
+	local := children[1].GetString()
+	return nameTestQNameNamespaceWithLocal(c, namespaceLookup, local)
+}
+
+func nameTestQNameNamespaceWithLocal(c *compiler, namespaceLookup, local string) (exprNode, error) {
+	namespaceValue, err := c.resolveNamespace(namespaceLookup)
+
+	if err != nil {
+		return nil, err
 	}
 
-	context.result = selectDescendantOrSelf(nodeSet)
-	return execContext(context, expr.Next(children[1]))
+	return childStep(nodeTest{
+		kind:  nodeTestQName,
+		space: namespaceValue,
+		local: local,
+	})
+}
+
+func compileNameTestQNameLocalOnly(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	queryName := expr.GetString()
+
+	return childStep(nodeTest{
+		kind:           nodeTestLocal,
+		local:          queryName,
+		namespaceValue: c.namespaces[queryName],
+	})
 }
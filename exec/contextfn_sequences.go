@@ -0,0 +1,182 @@
+package exec
+
+import (
+	"math"
+
+	"github.com/ChrisTrenkamp/xsel/grammar"
+	"github.com/ChrisTrenkamp/xsel/grammar/parser/symbols"
+)
+
+func init() {
+	compileFunctions[symbols.NT_ExprComma] = compileExprComma
+	compileFunctions[symbols.NT_PrimaryExprEmptySequence] = compileEmptySequence
+	compileFunctions[symbols.NT_RangeExprTo] = compileRangeExprTo
+}
+
+// sequenceOf returns the items as a Result.  If they are all nodes, they are
+// returned as a NodeSet, in document order and without duplicates, like the
+// union operator returns them.  Otherwise, they are returned as a Sequence,
+// even if there is only one, so count() and sum() accept it.
+func sequenceOf(items Sequence) Result {
+	nodeSet := make(NodeSet, 0, len(items))
+
+	for _, i := range items {
+		nodes, ok := i.(NodeSet)
+
+		if !ok {
+			return items
+		}
+
+		nodeSet = append(nodeSet, nodes...)
+	}
+
+	return unionCleanup(nodeSet)
+}
+
+// sequenceExpr is the XPath 2.0 comma operator, "(a, b, c)", and the empty
+// sequence, "()".  The items of each operand are joined together, in order,
+// unless they are all nodes.
+type sequenceExpr struct {
+	exprSpan
+	items []exprNode
+}
+
+func (e *sequenceExpr) exec(context *exprContext) error {
+	ret := make(Sequence, 0, len(e.items))
+
+	for _, i := range e.items {
+		result, err := execIndependent(context, i)
+
+		if err != nil {
+			return err
+		}
+
+		ret = append(ret, Items(result)...)
+
+		if err := context.checkSize(len(ret)); err != nil {
+			return err
+		}
+	}
+
+	context.result = sequenceOf(ret)
+	return nil
+}
+
+func (e *sequenceExpr) explain(x *explainer) {
+	x.line("Sequence")
+	x.children(e.items...)
+	x.extension("sequence expression")
+}
+
+func compileExprComma(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	first, rest, err := c.compileLeftRight(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, ok := rest.(*sequenceExpr)
+
+	// "()" is an item of the sequence, rather than the rest of it.
+	if !ok || len(sequence.items) == 0 {
+		sequence = &sequenceExpr{items: []exprNode{rest}}
+	}
+
+	sequence.items = append([]exprNode{first}, sequence.items...)
+	sequence.span = grammarSpan(expr)
+	return sequence, nil
+}
+
+func compileEmptySequence(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	return &sequenceExpr{}, nil
+}
+
+// MaxRangeSize is the most numbers a range may return.  Larger ranges fail
+// with a *LimitError, even if ContextSettings.MaxNodeSetSize allows them.
+const MaxRangeSize = 1 << 22
+
+// rangeExpr is the XPath 2.0 "from to to" operator.  Its operands are rounded
+// to integers, and it returns the numbers from the first to the second.  If
+// either operand is empty or not a finite number, or the first is greater
+// than the second, it returns the empty sequence.
+type rangeExpr struct {
+	exprSpan
+	from exprNode
+	to   exprNode
+}
+
+func (e *rangeExpr) exec(context *exprContext) error {
+	from, ok, err := e.operand(context, e.from)
+
+	if err != nil || !ok {
+		return err
+	}
+
+	to, ok, err := e.operand(context, e.to)
+
+	if err != nil || !ok {
+		return err
+	}
+
+	if from > to {
+		context.result = NodeSet{}
+		return nil
+	}
+
+	// Check the size before building the sequence, so a huge range fails
+	// without allocating it.
+	size := to - from + 1
+
+	if err := context.checkSize(int(math.Min(size, MaxRangeSize+1))); err != nil {
+		return err
+	}
+
+	if size > MaxRangeSize {
+		return &LimitError{Limit: LimitNodeSetSize, Max: MaxRangeSize}
+	}
+
+	ret := make(Sequence, 0)
+
+	for i := from; i <= to; i++ {
+		ret = append(ret, Number(i))
+	}
+
+	context.result = sequenceOf(ret)
+	return nil
+}
+
+// operand evaluates one of the operands.  It returns false, and leaves the
+// empty sequence in the context, if the operand is empty or not a finite
+// number.  The number value of an empty NodeSet or Sequence is NaN.
+func (e *rangeExpr) operand(context *exprContext, expr exprNode) (float64, bool, error) {
+	result, err := execIndependent(context, expr)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	ret := math.Floor(result.Number() + 0.5)
+
+	if math.IsNaN(ret) || math.IsInf(ret, 0) {
+		context.result = NodeSet{}
+		return 0, false, nil
+	}
+
+	return ret, true, nil
+}
+
+func (e *rangeExpr) explain(x *explainer) {
+	x.line("Range")
+	x.children(e.from, e.to)
+	x.extension("range expression")
+}
+
+func compileRangeExprTo(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	from, to, err := c.compileLeftRight(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &rangeExpr{from: from, to: to}, nil
+}
@@ -0,0 +1,221 @@
+package exec
+
+import (
+	"strings"
+
+	"github.com/ChrisTrenkamp/xsel/grammar"
+	"github.com/ChrisTrenkamp/xsel/grammar/parser/symbols"
+)
+
+func init() {
+	compileFunctions[symbols.NT_LetExpr] = compileLetExpr
+	compileFunctions[symbols.NT_ForExpr] = compileForExpr
+}
+
+// scope is a variable bound by a let, for, some or every expression, linked to
+// the ones bound by the expressions enclosing it.
+type scope struct {
+	name   XmlName
+	value  Result
+	parent *scope
+}
+
+// lookup returns the innermost binding of the variable.
+func (s *scope) lookup(name XmlName) (Result, bool) {
+	for ; s != nil; s = s.parent {
+		if s.name == name {
+			return s.value, true
+		}
+	}
+
+	return nil, false
+}
+
+// bind returns a copy of the context, with the variable bound to value.
+func (c *exprContext) bind(name XmlName, value Result) exprContext {
+	next := c.copy()
+	next.scope = &scope{name: name, value: value, parent: c.scope}
+	return next
+}
+
+// letExpr is the XPath 3.0 "let $x := value return body" expression.
+type letExpr struct {
+	exprSpan
+	name  XmlName
+	value exprNode
+	body  exprNode
+}
+
+func (e *letExpr) exec(context *exprContext) error {
+	value, err := execIndependent(context, e.value)
+
+	if err != nil {
+		return err
+	}
+
+	next := context.bind(e.name, value)
+
+	if err := execContext(&next, e.body); err != nil {
+		return err
+	}
+
+	context.result = next.result
+	return nil
+}
+
+func (e *letExpr) explain(x *explainer) {
+	x.line("Let $%s", e.name)
+	x.children(e.value, e.body)
+	x.extension("let expression")
+}
+
+// forExpr is the XPath 2.0 "for $x in items return body" expression.  body is
+// evaluated once for each item, in order, and the items it returns are joined
+// together.
+type forExpr struct {
+	exprSpan
+	name XmlName
+	in   exprNode
+	body exprNode
+}
+
+func (e *forExpr) exec(context *exprContext) error {
+	ret := make(Sequence, 0)
+
+	err := eachItem(context, e.in, func(item Result) (bool, error) {
+		next := context.bind(e.name, item)
+
+		if err := execContext(&next, e.body); err != nil {
+			return false, err
+		}
+
+		ret = append(ret, Items(next.result)...)
+		return true, context.checkSize(len(ret))
+	})
+
+	if err != nil {
+		return err
+	}
+
+	context.result = sequenceOf(ret)
+	return nil
+}
+
+func (e *forExpr) explain(x *explainer) {
+	x.line("For $%s", e.name)
+	x.children(e.in, e.body)
+	x.extension("for expression")
+}
+
+// eachItem calls fn with each of the items expr evaluates to, in order, until
+// fn returns false.  NodeSets are streamed if they can be, so the nodes after
+// the last one fn is called with may never be selected.
+func eachItem(context *exprContext, expr exprNode, fn func(item Result) (bool, error)) error {
+	if streams(context, expr) {
+		stream, err := streamContext(context, expr)
+
+		if err != nil {
+			return err
+		}
+
+		for {
+			node, err := stream.next()
+
+			if node == nil || err != nil {
+				return err
+			}
+
+			if more, err := fn(NodeSet{node}); !more || err != nil {
+				return err
+			}
+		}
+	}
+
+	result, err := execIndependent(context, expr)
+
+	if err != nil {
+		return err
+	}
+
+	for _, item := range Items(result) {
+		if more, err := fn(item); !more || err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func compileLetExpr(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
+	value, err := c.compile(children[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	name, body, err := c.compileScope(children[0], children[2])
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &letExpr{name: name, value: value, body: body}, nil
+}
+
+func compileForExpr(c *compiler, expr *grammar.Grammar) (exprNode, error) {
+	children := ntChildren(expr)
+	in, err := c.compile(children[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	name, body, err := c.compileScope(children[0], children[2])
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &forExpr{name: name, in: in, body: body}, nil
+}
+
+// compileScope compiles the body of an expression that binds a variable, with
+// the variable in scope.
+func (c *compiler) compileScope(variable, body *grammar.Grammar) (XmlName, exprNode, error) {
+	parent := c.expr
+	c.expr = variable
+	name, _, err := c.variableName(variable)
+	c.expr = parent
+
+	if err != nil {
+		return XmlName{}, nil, err
+	}
+
+	c.scope = append(c.scope, name)
+	ret, err := c.compile(body)
+	c.scope = c.scope[:len(c.scope)-1]
+
+	return name, ret, err
+}
+
+// variableName resolves the name of a variable reference.  It returns false
+// if the prefix could not be resolved and the query is being checked.
+func (c *compiler) variableName(expr *grammar.Grammar) (XmlName, bool, error) {
+	variableStr := expr.GetString()
+	variableStr = strings.TrimSpace(variableStr)
+	variableStr = strings.TrimPrefix(variableStr, "$")
+
+	return c.getQName(variableStr)
+}
+
+// bound reports whether the variable is bound by an enclosing expression.
+func (c *compiler) bound(name XmlName) bool {
+	for _, i := range c.scope {
+		if i == name {
+			return true
+		}
+	}
+
+	return false
+}
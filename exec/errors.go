@@ -0,0 +1,78 @@
+package exec
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The kinds of mistakes a XPath query can fail with.  errors.Is tells them
+// apart, and errors.As with an *ExprError finds the name and the part of the
+// query at fault.
+var (
+	// ErrUnknownFunction is a call to a function that is neither bound nor
+	// built-in.
+	ErrUnknownFunction = errors.New("unknown function")
+	// ErrUnboundVariable is a reference to a variable that is not bound.
+	ErrUnboundVariable = errors.New("unbound variable")
+	// ErrNotNodeSet is a NodeSet operation, such as a location step or a
+	// union, applied to another type of result.
+	ErrNotNodeSet = errors.New("cannot query nodes on non-NodeSet's")
+	// ErrArity is a function called with the wrong number of arguments.
+	ErrArity = errors.New("incorrect number of arguments")
+	// ErrUnknownPrefix is a namespace prefix that is not bound.
+	ErrUnknownPrefix = errors.New("unknown namespace prefix")
+	// ErrNotBoolean is a Sequence of more than one string, number or boolean
+	// used as a boolean.  Such a sequence has no boolean value.
+	ErrNotBoolean = errors.New("sequence has no boolean value")
+	// ErrPanic is a panic recovered while a query was compiled or executed.
+	ErrPanic = errors.New("xpath query panic")
+)
+
+// ExprError is an error raised by part of a XPath query.
+type ExprError struct {
+	// Name is the function, variable or namespace prefix at fault, if there
+	// is one.
+	Name string
+	// Span is the part of the query the error was raised by.
+	Span Span
+	Err  error
+}
+
+func (e *ExprError) Error() string {
+	return fmt.Sprintf("%s, in '%s' at offset %d", e.Err, e.Span.Text, e.Span.Start)
+}
+
+func (e *ExprError) Unwrap() error {
+	return e.Err
+}
+
+// kindError is an error message for one of the Err* sentinels.
+type kindError struct {
+	msg  string
+	kind error
+}
+
+func (e *kindError) Error() string {
+	return e.msg
+}
+
+func (e *kindError) Unwrap() error {
+	return e.kind
+}
+
+// errorf formats an error message for one of the Err* sentinels.
+func errorf(kind error, format string, args ...any) error {
+	return &kindError{msg: fmt.Sprintf(format, args...), kind: kind}
+}
+
+// exprError attaches the expression's span to an error raised by the engine
+// itself, unless it is already attached to part of the query.
+func exprError(expr exprNode, err error) error {
+	var exprErr *ExprError
+
+	if !errors.Is(err, ErrNotNodeSet) || errors.As(err, &exprErr) {
+		return err
+	}
+
+	return &ExprError{Span: *expr.spanOf(), Err: err}
+}
@@ -1,7 +1,7 @@
 package exec
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/ChrisTrenkamp/xsel/grammar"
 	"github.com/ChrisTrenkamp/xsel/store"
@@ -10,25 +10,136 @@ import (
 
 // Executes an XPath query against the given Cursor and returns the result.
 func Exec(cursor store.Cursor, expr *grammar.Grammar, settings ...ContextApply) (Result, error) {
+	return ExecContext(context.Background(), cursor, expr, settings...)
+}
+
+// Like Exec, except the query stops with the context's error as soon as ctx
+// is cancelled or its deadline passes.
+func ExecContext(ctx context.Context, cursor store.Cursor, expr *grammar.Grammar, settings ...ContextApply) (Result, error) {
+	contextSettings, err := buildContextSettings(settings...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := compileRecover(newCompiler(&contextSettings), expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return execExpr(ctx, cursor, compiled, contextSettings)
+}
+
+// Executes a compiled XPath query against the given Cursor and returns the result.
+func ExecExpr(cursor store.Cursor, expr *Expr, settings ...ContextApply) (Result, error) {
+	return ExecExprContext(context.Background(), cursor, expr, settings...)
+}
+
+// Like ExecExpr, except the query stops with the context's error as soon as
+// ctx is cancelled or its deadline passes.
+func ExecExprContext(ctx context.Context, cursor store.Cursor, expr *Expr, settings ...ContextApply) (Result, error) {
+	contextSettings, err := buildContextSettings(settings...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return execExpr(ctx, cursor, expr, contextSettings)
+}
+
+// buildContextSettings applies the settings, and returns the first error they
+// recorded with Fail.
+func buildContextSettings(settings ...ContextApply) (ContextSettings, error) {
 	contextSettings := ContextSettings{
-		Variables:       make(map[XmlName]Result),
-		FunctionLibrary: make(map[XmlName]Function),
-		NamespaceDecls:  make(map[string]string),
+		Variables:          make(map[XmlName]Result),
+		FunctionLibrary:    make(map[XmlName]Function),
+		FunctionSignatures: make(map[XmlName]Signature),
+		LazyFunctions:      make(map[XmlName]LazyFunction),
+		NamespaceDecls:     make(map[string]string),
+		IDAttributes:       []XmlName{xmlIDName},
+		DTDIDs:             true,
+		MaxRecursion:       DefaultMaxRecursion,
 	}
 
 	for _, i := range settings {
 		i(&contextSettings)
 	}
 
-	context := &exprContext{
-		root:             cursor,
+	return contextSettings, contextSettings.err
+}
+
+// Like Exec, except if the query returns a NodeSet, only its first node is
+// returned, and the query stops as soon as it is found.
+func ExecFirst(cursor store.Cursor, expr *grammar.Grammar, settings ...ContextApply) (Result, error) {
+	contextSettings, err := buildContextSettings(settings...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := compileRecover(newCompiler(&contextSettings), expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return execFirst(newExprContext(context.Background(), cursor, contextSettings), compiled.root)
+}
+
+// Iterate executes a compiled XPath query against the given Cursor, and calls
+// fn with each node it selects, in the order Exec would return them, until fn
+// returns false.  Nodes are selected as fn asks for them, so the query does no
+// more work than it has to.  The query must return a NodeSet.
+func Iterate(cursor store.Cursor, expr *Expr, fn func(store.Cursor) bool, settings ...ContextApply) error {
+	return IterateContext(context.Background(), cursor, expr, fn, settings...)
+}
+
+// Like Iterate, except the query stops with the context's error as soon as
+// ctx is cancelled or its deadline passes.
+func IterateContext(ctx context.Context, cursor store.Cursor, expr *Expr, fn func(store.Cursor) bool, settings ...ContextApply) error {
+	contextSettings, err := buildContextSettings(settings...)
+
+	if err != nil {
+		return err
+	}
+
+	return iterate(newExprContext(ctx, cursor, contextSettings), expr.root, fn)
+}
+
+func newExprContext(ctx context.Context, cursor store.Cursor, contextSettings ContextSettings) *exprContext {
+	root := contextSettings.DocumentRoot
+
+	if root == nil {
+		root = cursor
+	}
+
+	position := 1
+
+	if contextSettings.Position > 0 {
+		position = contextSettings.Position
+	}
+
+	size := position
+
+	if contextSettings.Size > size {
+		size = contextSettings.Size
+	}
+
+	return &exprContext{
+		root:             root,
 		result:           Result(NodeSet{cursor}),
-		contextPosition:  0,
+		contextPosition:  position - 1,
+		contextSize:      size,
 		builtinFunctions: builtinFunctions,
+		state:            &evalState{ctx: ctx},
 		ContextSettings:  contextSettings,
 	}
+}
 
-	err := execRecover(context, expr)
+func execExpr(ctx context.Context, cursor store.Cursor, expr *Expr, contextSettings ContextSettings) (Result, error) {
+	context := newExprContext(ctx, cursor, contextSettings)
+	err := execRecover(context, expr.root)
 
 	if err != nil {
 		return nil, err
@@ -37,14 +148,68 @@ func Exec(cursor store.Cursor, expr *grammar.Grammar, settings ...ContextApply)
 	return context.result, nil
 }
 
-func execRecover(context *exprContext, expr *grammar.Grammar) (err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = errors.Wrapf(fmt.Errorf("xpath query panic"), "%s", r)
-		}
-	}()
+func execRecover(context *exprContext, expr exprNode) (err error) {
+	defer recoverQuery(&err)
 
 	err = execContext(context, expr)
 
 	return
 }
+
+func execFirst(context *exprContext, expr exprNode) (result Result, err error) {
+	defer recoverQuery(&err)
+
+	if !streams(context, expr) {
+		if err := execContext(context, expr); err != nil {
+			return nil, err
+		}
+
+		return context.result, nil
+	}
+
+	stream, err := streamContext(context, expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	first, err := stream.next()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if first == nil {
+		return NodeSet{}, nil
+	}
+
+	return NodeSet{first}, nil
+}
+
+func iterate(context *exprContext, expr exprNode, fn func(store.Cursor) bool) (err error) {
+	defer recoverQuery(&err)
+
+	stream, err := streamContext(context, expr)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		next, err := stream.next()
+
+		if next == nil || err != nil {
+			return err
+		}
+
+		if !fn(next) {
+			return nil
+		}
+	}
+}
+
+func recoverQuery(err *error) {
+	if r := recover(); r != nil {
+		*err = errors.Wrapf(ErrPanic, "%s", r)
+	}
+}
@@ -2,11 +2,17 @@ package exec
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"math"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/ChrisTrenkamp/xsel/grammar"
+	"github.com/ChrisTrenkamp/xsel/grammar/lexer"
 	"github.com/ChrisTrenkamp/xsel/node"
 	"github.com/ChrisTrenkamp/xsel/parser"
 	"github.com/ChrisTrenkamp/xsel/store"
@@ -1118,6 +1124,456 @@ func TestFunctionLast(t *testing.T) {
 	execXmlNodesToString(t, "/root/a[last()]", xml, "b")
 }
 
+func TestContextSize(t *testing.T) {
+	xml := `
+<root>
+	<a>a</a>
+	<a>b</a>
+	<a>c</a>
+	<c>d</c>
+</root>`
+
+	execXmlNodesToString(t, "/root/a[last()]", xml, "c")
+	execXmlNodesToString(t, "/root/a[position() = last() - 1]", xml, "b")
+	execXmlNodesToString(t, "(/root/a)[last()]", xml, "c")
+	execXmlNodesToString(t, "/root/a[. != 'a'][last() - 1]", xml, "b")
+	execXmlNodesToString(t, "/root/c/preceding-sibling::a[last()]", xml, "a")
+	execXmlNodesToString(t, "/root/c/preceding-sibling::a[1]", xml, "c")
+	execXml(t, "count(/root/*[last()])", xml, Number(1))
+
+	sizes := func(c *ContextSettings) {
+		c.FunctionLibrary[XmlName{Local: "size"}] = func(context Context, args ...Result) (Result, error) {
+			return Number(context.ContextSize()), nil
+		}
+	}
+
+	execXmlNodesToString(t, "/root/*[size() = 4][4]", xml, "d", sizes)
+}
+
+func TestFunctionContext(t *testing.T) {
+	xml := `<root><item id="a">A</item><item id="b">B</item><link ref="b"/></root>`
+	items := grammar.MustBuild("//item")
+	itemsExpr := MustCompile(&items)
+	id := grammar.MustBuild("string(@id)")
+	idExpr := MustCompile(&id)
+
+	settings := func(c *ContextSettings) {
+		c.NamespaceDecls["p"] = "http://p"
+		c.Variables[XmlName{Local: "v"}] = String("value")
+		c.FunctionLibrary[XmlName{Local: "deref"}] = func(context Context, args ...Result) (Result, error) {
+			items, err := context.Evaluate(itemsExpr, context.Root())
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, i := range items.(NodeSet) {
+				id, err := context.Evaluate(idExpr, i)
+
+				if err != nil {
+					return nil, err
+				}
+
+				if id.String() == args[0].String() {
+					return NodeSet{i}, nil
+				}
+			}
+
+			return NodeSet{}, nil
+		}
+		c.FunctionLibrary[XmlName{Local: "space"}] = func(context Context, args ...Result) (Result, error) {
+			name, err := context.ResolveQName(args[0].String())
+			return String(name.Space), err
+		}
+		c.FunctionLibrary[XmlName{Local: "var"}] = func(context Context, args ...Result) (Result, error) {
+			value, ok := context.Variable(XmlName{Local: args[0].String()})
+
+			if !ok {
+				return String("unbound"), nil
+			}
+
+			return value, nil
+		}
+		c.FunctionLibrary[XmlName{Local: "is-root"}] = func(context Context, args ...Result) (Result, error) {
+			return Bool(context.Root().Pos() == 0 && context.Namespaces()["p"] == "http://p"), nil
+		}
+	}
+
+	execXmlNodesToString(t, "deref(//link/@ref)", xml, "B", settings)
+	execXml(t, "space('p:x')", xml, String("http://p"), settings)
+	execXml(t, "var('v')", xml, String("value"), settings)
+	execXml(t, "var('w')", xml, String("unbound"), settings)
+	execXml(t, "//link[is-root()]/@ref = 'b'", xml, Bool(true), settings)
+}
+
+func TestFunctionSignature(t *testing.T) {
+	xml := `<root><a>3</a><b>x</b></root>`
+	name := XmlName{Local: "repeat"}
+	sig := Signature{
+		Params: []Param{
+			{Name: "s", Type: TypeString},
+			{Name: "n", Type: TypeNumber, Optional: true},
+			{Name: "sep", Type: TypeString, Optional: true, Variadic: true},
+		},
+		Returns: TypeString,
+	}
+
+	repeat := func(context Context, args ...Result) (Result, error) {
+		count := 2
+		sep := ""
+
+		if len(args) > 1 {
+			count = int(args[1].(Number))
+		}
+
+		for i := 2; i < len(args); i++ {
+			sep += string(args[i].(String))
+		}
+
+		return String(strings.Repeat(string(args[0].(String))+sep, count)), nil
+	}
+
+	settings := func(c *ContextSettings) {
+		c.FunctionLibrary[name] = sig.Bind(repeat)
+		c.FunctionSignatures[name] = sig
+
+		total := XmlName{Local: "total"}
+		c.FunctionLibrary[total] = Signature{Params: []Param{{Type: TypeNodeSet}}, Returns: TypeNumber}.Bind(func(context Context, args ...Result) (Result, error) {
+			return String("42"), nil
+		})
+	}
+
+	execXml(t, "repeat(/root/b)", xml, String("xx"), settings)
+	execXml(t, "repeat('y', /root/a)", xml, String("yyy"), settings)
+	execXml(t, "repeat('y', '1', '-', 1)", xml, String("y-1"), settings)
+	execXml(t, "total(/root/a)", xml, Number(42), settings)
+
+	for _, i := range []string{"repeat()", "total('a')"} {
+		if err := queryXmlErr(t, context.Background(), i, xml, settings); err == nil {
+			t.Errorf("%s: expected an error", i)
+		}
+	}
+
+	xpath := grammar.MustBuild("repeat() or total(1, 2)")
+	errs := Check(&xpath, settings)
+
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "function repeat expects at least 1 arguments, received 0") {
+		t.Errorf("unexpected errors %v", errs)
+	}
+
+	if sig.String() != "($s as string, $n as number?, $sep as string*) string" {
+		t.Errorf("unexpected signature %s", sig)
+	}
+}
+
+func TestLazyFunction(t *testing.T) {
+	xml := `<root><a>a</a><b/></root>`
+	evaluated := 0
+
+	settings := func(c *ContextSettings) {
+		c.LazyFunctions[XmlName{Local: "coalesce"}] = func(context Context, args ...Thunk) (Result, error) {
+			for _, i := range args {
+				result, err := i()
+
+				if err != nil {
+					return nil, err
+				}
+
+				if result.String() != "" {
+					return result, nil
+				}
+			}
+
+			return String(""), nil
+		}
+		c.LazyFunctions[XmlName{Local: "try"}] = func(context Context, args ...Thunk) (Result, error) {
+			if result, err := args[0](); err == nil {
+				return result, nil
+			}
+
+			return args[1]()
+		}
+		c.FunctionLibrary[XmlName{Local: "count-me"}] = func(context Context, args ...Result) (Result, error) {
+			evaluated++
+			return String("counted"), nil
+		}
+	}
+
+	execXml(t, "string(coalesce(/root/b, /root/a, count-me()))", xml, String("a"), settings)
+	execXml(t, "try(unknown(), 'fallback')", xml, String("fallback"), settings)
+	execXml(t, "name(/root/*[coalesce(., 'empty') = 'empty'])", xml, String("b"), settings)
+
+	if evaluated != 0 {
+		t.Errorf("expected the unused arguments to be left unevaluated, evaluated %d", evaluated)
+	}
+}
+
+func TestFunctionLibrary(t *testing.T) {
+	xml := `<root><a>3</a><a>4</a><a>8</a></root>`
+	strs := StringsLibrary().Apply
+	maths := MathLibrary().Apply
+
+	execXml(t, "str:upper-case(/root/a[3] + 1)", xml, String("9"), strs)
+	execXml(t, "str:join(/root/a, ',')", xml, String("3,4,8"), strs)
+	execXml(t, "str:replace(str:trim(' a1 '), '[0-9]', 'n')", xml, String("an"), strs)
+	execXml(t, "str:matches('abc', '^a.c$') and str:ends-with('abc', 'bc')", xml, Bool(true), strs)
+	execXml(t, "math:max(/root/a) - math:min(/root/a)", xml, Number(5), maths)
+	execXml(t, "math:avg(/root/a)", xml, Number(5), maths)
+	execXml(t, "math:pow(2, 3) + math:sqrt(16) + math:abs(-1)", xml, Number(13), maths)
+	execXml(t, "math:max((1, /root/a, 5)) + math:avg(1 to 3)", xml, Number(10), maths)
+	execXml(t, "str:join((/root/a[1], 'x', 1 to 2), '-')", xml, String("3-x-1-2"), strs)
+
+	lib := &FunctionLibrary{
+		Namespace: "http://acme",
+		Prefix:    "acme",
+		Functions: []LibraryFunction{
+			{
+				Local:     "first",
+				Signature: Signature{Params: []Param{{Type: TypeAny, Variadic: true}}},
+				Lazy: func(context Context, args ...Thunk) (Result, error) {
+					return args[0]()
+				},
+			},
+		},
+	}
+
+	// A prefix bound by the query takes precedence over the library's.
+	execXml(t, "x:first('a', unknown())", xml, String("a"), lib.Apply, func(c *ContextSettings) {
+		c.NamespaceDecls["acme"] = "http://other"
+		c.NamespaceDecls["x"] = "http://acme"
+	})
+
+	if err := queryXmlErr(t, context.Background(), "acme:first()", xml, lib.Apply); err == nil {
+		t.Error("expected an error for a call with too few arguments")
+	}
+
+	functions := make([]string, 0)
+
+	for _, i := range ListFunctions(lib.Apply) {
+		if !i.Builtin || i.Name.Local == "substring" {
+			functions = append(functions, i.String())
+		}
+	}
+
+	expected := []string{"substring(string, number, number?) string", "{http://acme}first(object+) object"}
+
+	if !reflect.DeepEqual(functions, expected) {
+		t.Errorf("expected %v, received %v", expected, functions)
+	}
+
+	// Exactly one of Function and Lazy must be set.
+	invalid := []LibraryFunction{
+		{Local: "neither"},
+		{Local: "both", Function: strUpperCase, Lazy: lib.Functions[0].Lazy},
+	}
+
+	for _, i := range invalid {
+		lib := &FunctionLibrary{Namespace: "http://acme", Functions: []LibraryFunction{i}}
+
+		if err := queryXmlErr(t, context.Background(), "1", xml, lib.Apply); err == nil || !strings.Contains(err.Error(), i.Local) {
+			t.Errorf("expected an error for %s, received %v", i.Local, err)
+		}
+	}
+}
+
+func TestResolvers(t *testing.T) {
+	xml := `<root><a>3</a><a>4</a></root>`
+	lookups := 0
+	failure := errors.New("store unavailable")
+
+	variables := func(c *ContextSettings) {
+		c.Variables[XmlName{Local: "bound"}] = Number(1)
+		c.VariableResolver = func(name XmlName) (Result, bool, error) {
+			lookups++
+
+			switch name.Local {
+			case "bound":
+				t.Error("the resolver should not be asked for bound variables")
+			case "two":
+				return Number(2), true, nil
+			case "broken":
+				return nil, false, failure
+			}
+
+			return nil, false, nil
+		}
+	}
+
+	functions := func(c *ContextSettings) {
+		c.FunctionResolver = func(name XmlName, arity int) (Function, bool) {
+			if name.Local != "twice" || arity != 1 {
+				return nil, false
+			}
+
+			return func(context Context, args ...Result) (Result, error) {
+				return Number(args[0].Number() * 2), nil
+			}, true
+		}
+	}
+
+	execXml(t, "$bound + $two + /root/a[. > $two][. > $two]", xml, Number(6), variables)
+
+	if lookups != 1 {
+		t.Errorf("expected the variable to be resolved once, resolved %d times", lookups)
+	}
+
+	execXml(t, "twice(/root/a[1]) + count(/root/a)", xml, Number(8), functions)
+
+	if err := queryXmlErr(t, context.Background(), "$broken", xml, variables); !errors.Is(err, failure) {
+		t.Errorf("expected the resolver's error, received %v", err)
+	}
+
+	if err := queryXmlErr(t, context.Background(), "$missing", xml, variables); err == nil {
+		t.Error("expected an error for an unknown variable")
+	}
+
+	if err := queryXmlErr(t, context.Background(), "twice(1, 2)", xml, functions); err == nil {
+		t.Error("expected an error for an unresolved function")
+	}
+
+	xpath := grammar.MustBuild("twice($two) + thrice($broken)")
+	errs := Check(&xpath, variables, functions)
+
+	if len(errs) != 2 || !errors.Is(errs[1], failure) {
+		t.Errorf("expected thrice and $broken to be reported, received %v", errs)
+	}
+}
+
+func TestXPathFunction(t *testing.T) {
+	xml := `
+<orders>
+	<order id="a"><line qty="1" price="3"/><line qty="2" price="5"/></order>
+	<order id="b"><line qty="4" price="2"/></order>
+</orders>`
+
+	acme := func(c *ContextSettings) {
+		c.NamespaceDecls["acme"] = "http://acme"
+	}
+
+	bind := func(local string, params []string, body string) ContextApply {
+		xpath := grammar.MustBuild(body)
+		fn, err := CompileFunction(params, &xpath, acme)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return func(c *ContextSettings) {
+			name := XmlName{Space: "http://acme", Local: local}
+			c.FunctionSignatures[name] = fn.Signature()
+			c.FunctionLibrary[name] = fn.Function()
+		}
+	}
+
+	total := bind("total", []string{"order"}, "sum($order/line/(@qty * @price))")
+	quantity := bind("quantity", []string{"i"}, "sum(/orders/order[@id = string(/orders/order[$i]/@id)]/line/@qty)")
+	down := bind("down", []string{"n"}, "$n <= 0 or acme:down($n - 1)")
+	loop := bind("loop", []string{"n"}, "acme:loop($n + 1)")
+
+	execXml(t, "acme:total(/orders/order[1]) * 100 + acme:total(/orders/order[2])", xml, Number(1308), acme, total)
+	execXmlNodesToString(t, "/orders/order[acme:total(.) < 10]/@id", xml, "b", acme, total)
+	execXml(t, "acme:quantity(1) * 10 + acme:quantity(2)", xml, Number(34), acme, quantity)
+	execXml(t, "acme:down(50)", xml, Bool(true), acme, down)
+
+	err := queryXmlErr(t, context.Background(), "acme:loop(0)", xml, acme, loop, func(c *ContextSettings) {
+		c.MaxRecursion = 10
+	})
+
+	if limit := (*LimitError)(nil); !errors.As(err, &limit) || limit.Limit != LimitRecursion {
+		t.Errorf("expected a recursion limit error, received %v", err)
+	}
+
+	if err := queryXmlErr(t, context.Background(), "acme:total()", xml, acme, total); err == nil {
+		t.Error("expected an error for a call with too few arguments")
+	}
+}
+
+func TestQuery(t *testing.T) {
+	xml := `<root><a/><a/></root>`
+	cursor, err := store.CreateInMemory(parser.ReadXml(bytes.NewBufferString(xml)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xpath := grammar.MustBuild("$x + count(/root/a[. = ''])")
+	query, err := Prepare(&xpath, func(c *ContextSettings) {
+		c.Variables[XmlName{Local: "x"}] = Number(0)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			result, err := query.Run(cursor, func(c *ContextSettings) {
+				c.Variables[XmlName{Local: "x"}] = Number(i)
+			})
+
+			if err != nil {
+				t.Error(err)
+			} else if result != Number(i+2) {
+				t.Errorf("expected %d, received %s", i+2, result)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if result, err := query.Run(cursor); err != nil || result != Number(2) {
+		t.Errorf("expected the overrides to leave the query unchanged, received %v, %v", result, err)
+	}
+}
+
+func TestSettingsFail(t *testing.T) {
+	cursor, err := store.CreateInMemory(parser.ReadXml(bytes.NewBufferString(`<root/>`)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := errors.New("first")
+	fail := func(c *ContextSettings) {
+		c.Fail(first)
+		c.Fail(errors.New("second"))
+	}
+
+	xpath := grammar.MustBuild("/root")
+
+	if _, err := Exec(cursor, &xpath, fail); err != first {
+		t.Errorf("Exec: expected %v, received %v", first, err)
+	}
+
+	if _, err := Compile(&xpath, fail); err != first {
+		t.Errorf("Compile: expected %v, received %v", first, err)
+	}
+
+	if errs := Check(&xpath, fail); len(errs) != 1 || errs[0] != first {
+		t.Errorf("Check: expected %v, received %v", first, errs)
+	}
+
+	query, err := Prepare(&xpath)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := query.Run(cursor, fail); err != first {
+		t.Errorf("Run: expected %v, received %v", first, err)
+	}
+
+	if err := Iterate(cursor, query.Expr(), func(store.Cursor) bool { return true }, fail); err != first {
+		t.Errorf("Iterate: expected %v, received %v", first, err)
+	}
+}
+
 func TestFunctionPosition(t *testing.T) {
 	xml := `
 <root>
@@ -1174,211 +1630,1400 @@ func TestFunctionName(t *testing.T) {
 	execXmlNodesToString(t, "name(/*)", xml, "{http://foo}root")
 }
 
-func TestFunctionString(t *testing.T) {
-	xml := `<root>1</root>`
+func TestFunctionString(t *testing.T) {
+	xml := `<root>1</root>`
+
+	execXmlNodesToString(t, "string(/root)", xml, "1")
+	execXmlNodesToString(t, "/root/string()", xml, "1")
+}
+
+func TestFunctionConcat(t *testing.T) {
+	execXmlNodesToString(t, "concat('foo', 'bar')", ``, "foobar")
+}
+
+func TestFunctionStartsWith(t *testing.T) {
+	execXmlNodesToString(t, "starts-with('abcd', 'ab')", ``, "true")
+	execXmlNodesToString(t, "starts-with('abcd', 'b')", ``, "false")
+}
+
+func TestFunctionContains(t *testing.T) {
+	execXmlNodesToString(t, "contains('abcd', 'bc')", ``, "true")
+	execXmlNodesToString(t, "contains('abcd', 'z')", ``, "false")
+}
+
+func TestFunctionSubstringBefore(t *testing.T) {
+	execXmlNodesToString(t, `substring-before("1999/04/01","/")`, ``, "1999")
+	execXmlNodesToString(t, `substring-before("1999/04/01","2")`, ``, "")
+}
+
+func TestFunctionSubstringAfter(t *testing.T) {
+	execXmlNodesToString(t, `substring-after("1999/04/01","/")`, ``, "04/01")
+	execXmlNodesToString(t, `substring-after("1999/04/01","19")`, ``, "99/04/01")
+	execXmlNodesToString(t, `substring-after("1999/04/01","a")`, ``, "")
+}
+
+func TestFunctionSubstring(t *testing.T) {
+	execXmlNodesToString(t, `substring("12345", 2, 3)`, ``, "234")
+	execXmlNodesToString(t, `substring("12345", 2)`, ``, "2345")
+	execXmlNodesToString(t, `substring('abcd', -2, 5)`, ``, "ab")
+	execXmlNodesToString(t, `substring('abcd', 0)`, ``, "abcd")
+	execXmlNodesToString(t, `substring('abcd', 1, 4)`, ``, "abcd")
+	execXmlNodesToString(t, `substring("12345", 1.5, 2.6)`, ``, "234")
+	execXmlNodesToString(t, `substring("12345", 0 div 0, 3)`, ``, "")
+	execXmlNodesToString(t, `substring("12345", 1, 0 div 0)`, ``, "")
+	execXmlNodesToString(t, `substring("12345", -42, 1 div 0)`, ``, "12345")
+	execXmlNodesToString(t, `substring("12345", -1 div 0, 1 div 0)`, ``, "")
+}
+
+func TestFunctionStringLength(t *testing.T) {
+	xml := `<root>1234</root>`
+
+	execXmlNodesToString(t, "string-length(/root)", xml, "4")
+	execXmlNodesToString(t, "/root/string-length()", xml, "4")
+}
+
+func TestFunctionNormalizeSpace(t *testing.T) {
+	xml := `<root>  1234   </root>`
+
+	execXmlNodesToString(t, "normalize-space(/root)", xml, "1234")
+	execXmlNodesToString(t, "/root/normalize-space()", xml, "1234")
+}
+
+func TestFunctionTranslate(t *testing.T) {
+	execXmlNodesToString(t, `translate("bar","abc","ABC")`, ``, "BAr")
+	execXmlNodesToString(t, `translate("--aaa--","abc-","ABC")`, ``, "AAA")
+}
+
+func TestFunctionNot(t *testing.T) {
+	execXml(t, `not(1)`, ``, Bool(false))
+	execXml(t, `not(0)`, ``, Bool(true))
+}
+
+func TestFunctionTrue(t *testing.T) {
+	execXml(t, `true()`, ``, Bool(true))
+}
+
+func TestFunctionFalse(t *testing.T) {
+	execXml(t, `false()`, ``, Bool(false))
+}
+
+func TestFunctionLang(t *testing.T) {
+	xml := `
+<p1>
+	<p xml:lang="en">I went up a floor.</p>
+	<p xml:lang="en-GB">I took the lift.</p>
+	<p xml:lang="en-US">I rode the elevator.</p>
+</p1>`
+
+	execXmlNodesToString(t, `count(//p[lang('en')])`, xml, "3")
+	execXmlNodesToString(t, `count(//text()[lang('en-GB')])`, xml, "1")
+	execXmlNodesToString(t, `count(//p[lang('en-US')])`, xml, "1")
+	execXmlNodesToString(t, `count(//p[lang('de')])`, xml, "0")
+	execXmlNodesToString(t, `count(/p1[lang('en')])`, xml, "0")
+}
+
+func TestFunctionNumber(t *testing.T) {
+	xml := `<root>1234</root>`
+
+	execXml(t, "number(/root)", xml, Number(1234))
+	execXml(t, "/root/number()", xml, Number(1234))
+}
+
+func TestFunctionSum(t *testing.T) {
+	xml := `
+<root>
+	<a>1</a>
+	<a>2</a>
+	<a>3</a>
+</root>
+`
+
+	execXml(t, "sum(/root/a)", xml, Number(6))
+}
+
+func TestFunctionFloor(t *testing.T) {
+	execXml(t, "floor(2.2)", ``, Number(2))
+}
+
+func TestFunctionCeiling(t *testing.T) {
+	execXml(t, "ceiling(2.2)", ``, Number(3))
+}
+
+func TestFunctionRound(t *testing.T) {
+	execXml(t, `round(-1.5)`, ``, Number(-2))
+	execXml(t, `round(1.5)`, ``, Number(2))
+	execXml(t, `round(0)`, ``, Number(0))
+}
+
+func TestCustomFunction(t *testing.T) {
+	xml := `
+<root>
+	<a>5</a>
+	<b>2.5</b>
+	<c>6</c>
+</root>
+`
+	variables := func(c *ContextSettings) {
+		c.NamespaceDecls["foo"] = "http://root"
+		c.FunctionLibrary[XmlName{"http://root", "bar"}] = func(context Context, args ...Result) (Result, error) {
+			return Number(2.5), nil
+		}
+	}
+
+	nodes := execXmlNodes(t, "//*[. = foo:bar()]", xml, variables)
+
+	if len(nodes) != 1 {
+		t.Error("Size is not 1")
+	}
+
+	b := nodes[0]
+
+	if (store.Cursor)(b).Node().(node.Element).Local() != "b" {
+		t.Error("Node not 'b'")
+	}
+}
+
+func TestVariableReference(t *testing.T) {
+	xml := `
+<root>
+	<a>5</a>
+	<b>2.5</b>
+	<c>6</c>
+</root>
+`
+	variables := func(c *ContextSettings) {
+		c.NamespaceDecls["foo"] = "http://root"
+		c.Variables[XmlName{"http://root", "bar"}] = Number(2.5)
+	}
+
+	nodes := execXmlNodes(t, "//*[. = $foo:bar]", xml, variables)
+
+	if len(nodes) != 1 {
+		t.Error("Size is not 1")
+	}
+
+	b := nodes[0]
+
+	if (store.Cursor)(b).Node().(node.Element).Local() != "b" {
+		t.Error("Node not 'b'")
+	}
+}
+
+func TestNewContext(t *testing.T) {
+	xml := `<root><a>5</a><b>2.5</b><c>6</c></root>`
+
+	xpath, err := grammar.Build("a[1]")
+
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	parser := parser.ReadXml(bytes.NewBufferString(xml))
+	cursor, err := store.CreateInMemory(parser)
+
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	result, err := Exec(cursor.Children()[0], &xpath)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if result.String() != "5" {
+		t.Errorf("Result != '5'")
+	}
+}
+
+func TestCompile(t *testing.T) {
+	xpath := grammar.MustBuild("/foo:root/foo:a[. = $v]")
+	expr, err := Compile(&xpath, func(c *ContextSettings) {
+		c.NamespaceDecls["foo"] = "http://foo"
+	})
+
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for _, i := range []string{"1", "2"} {
+		parser := parser.ReadXml(bytes.NewBufferString(`<root xmlns="http://foo"><a>1</a><a>2</a></root>`))
+		cursor, err := store.CreateInMemory(parser)
+
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		result, err := ExecExpr(cursor, expr, func(c *ContextSettings) {
+			c.Variables[XmlName{Local: "v"}] = String(i)
+		})
+
+		if err != nil {
+			t.Error(err)
+		}
+
+		if result.String() != i {
+			t.Errorf("Result != '%s'. Received '%s'", i, result.String())
+		}
+	}
+}
+
+func TestCompileUnknownNamespace(t *testing.T) {
+	xpath := grammar.MustBuild("/foo:root")
+
+	if _, err := Compile(&xpath); err == nil {
+		t.Error("expected an unknown namespace binding error")
+	}
+}
+
+func TestAbsolutePathInPredicate(t *testing.T) {
+	xml := `
+<root>
+	<default>2</default>
+	<a x="1">a</a>
+	<a x="2">b</a>
+</root>
+`
+
+	execXmlNodesToString(t, "//a[@x = /root/default]", xml, "b")
+	execXmlNodesToString(t, "//a[@x = //default]", xml, "b")
+}
+
+func TestFilterExprWithPath(t *testing.T) {
+	xml := `
+<root>
+	<a><b>1</b></a>
+	<a><c><b>2</b></c></a>
+</root>
+`
+
+	execXml(t, "count((/root/a)/b)", xml, Number(1))
+	execXml(t, "count((/root/a)//b)", xml, Number(2))
+}
+
+func queryXmlErr(t *testing.T, ctx context.Context, expr, xml string, settings ...ContextApply) error {
+	xpath := grammar.MustBuild(expr)
+	cursor, err := store.CreateInMemory(parser.ReadXml(bytes.NewBufferString(xml)))
+
+	if err != nil {
+		t.Error(err)
+		return nil
+	}
+
+	_, err = ExecContext(ctx, cursor, &xpath, settings...)
+	return err
+}
+
+func TestExecContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := queryXmlErr(t, ctx, "//*[count(//*) > 0]", `<root><a/><b/></root>`)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, received %v", err)
+	}
+}
+
+func TestExecLimits(t *testing.T) {
+	xml := `<root><a/><a/><a/><a/></root>`
+
+	limits := []struct {
+		limit    Limit
+		settings ContextApply
+	}{
+		{LimitNodesVisited, func(c *ContextSettings) { c.MaxNodesVisited = 10 }},
+		{LimitDepth, func(c *ContextSettings) { c.MaxDepth = 4 }},
+		{LimitNodeSetSize, func(c *ContextSettings) { c.MaxNodeSetSize = 3 }},
+	}
+
+	for _, i := range limits {
+		err := queryXmlErr(t, context.Background(), "//a[count(../a) > 0]", xml, i.settings)
+		limitErr := &LimitError{}
+
+		if !errors.As(err, &limitErr) {
+			t.Errorf("expected a LimitError for %s, received %v", i.limit, err)
+			continue
+		}
+
+		if limitErr.Limit != i.limit {
+			t.Errorf("expected limit %s, received %s", i.limit, limitErr.Limit)
+		}
+	}
+
+	// Streamed NodeSets are limited as they are gathered, including the ones
+	// gathered to count the context size.
+	for _, i := range []string{"/root/a", "/root/*[position() < 5]", "/root/a[last()]", "(/root/a)[last()]"} {
+		err := queryXmlErr(t, context.Background(), i, xml, func(c *ContextSettings) { c.MaxNodeSetSize = 3 })
+		limitErr := &LimitError{}
+
+		if !errors.As(err, &limitErr) || limitErr.Limit != LimitNodeSetSize {
+			t.Errorf("%s: expected a LimitError, received %v", i, err)
+		}
+	}
+
+	err := queryXmlErr(t, context.Background(), "//a[count(../a) > 0]", xml, func(c *ContextSettings) {
+		c.MaxNodesVisited = 1000
+		c.MaxDepth = 100
+		c.MaxNodeSetSize = 100
+	})
+
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	xpath := grammar.MustBuild(`//a[@id = 'x']/count(b)`)
+	expr := MustCompile(&xpath)
+
+	expected := `Path
+  Root
+  Step descendant::a
+    Predicate
+      Operator =
+        Step attribute::id
+        Literal "x"
+  FunctionCall count/1
+    Step child::b
+Extensions:
+  mid-path function call: count()
+`
+
+	if result := Explain(expr); result != expected {
+		t.Errorf("expected:\n%s\nreceived:\n%s", expected, result)
+	}
+}
+
+func TestExplainExtensions(t *testing.T) {
+	xpath := grammar.MustBuild(`/*:root/ns:#obj | /ns:*`)
+	expr := MustCompile(&xpath, func(c *ContextSettings) {
+		c.NamespaceDecls["ns"] = "http://ns"
+	})
+
+	expected := `Operator |
+  Path
+    Root
+    Step child::*:root
+    Step child::{http://ns}#obj
+  Path
+    Root
+    Step child::{http://ns}*
+Extensions:
+  namespace wildcard: *:root
+  '#' in name: #obj
+`
+
+	if result := Explain(expr); result != expected {
+		t.Errorf("expected:\n%s\nreceived:\n%s", expected, result)
+	}
+}
+
+type recordingTracer struct {
+	depth  int
+	events []string
+}
+
+func (r *recordingTracer) Enter(event TraceEvent) {
+	r.depth++
+}
+
+func (r *recordingTracer) Leave(event TraceEvent) {
+	r.depth--
+	r.events = append(r.events, fmt.Sprintf("%d %d:%d %s %d %d", r.depth, event.Span.Start, event.Span.End, event.Span.Text, event.InputSize, event.OutputSize))
+}
+
+func TestTracer(t *testing.T) {
+	tracer := &recordingTracer{}
+	err := queryXmlErr(t, context.Background(), "//a[1]", `<root><a/><a/></root>`, func(c *ContextSettings) {
+		c.Tracer = tracer
+	})
+
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expected := []string{
+		"1 0:2 // 1 1",
+		"3 4:5 1 1 -1",
+		"3 4:5 1 1 -1",
+		"2 3:6 [1] 2 1",
+		"1 0:6 //a[1] 1 1",
+		"0 0:6 //a[1] 1 1",
+	}
+
+	if !reflect.DeepEqual(tracer.events, expected) {
+		t.Errorf("expected %v, received %v", expected, tracer.events)
+	}
+}
+
+func TestProfiler(t *testing.T) {
+	profiler := NewProfiler()
+
+	for i := 0; i < 2; i++ {
+		err := queryXmlErr(t, context.Background(), "/root/a[@b]", `<root><a b="1"/><a/><a/></root>`, func(c *ContextSettings) {
+			c.Tracer = profiler
+		})
+
+		if err != nil {
+			t.Error(err)
+			return
+		}
+	}
+
+	expected := []ProfileStep{
+		{Span: Span{0, 11, "/root/a[@b]"}, Kind: "Path", Calls: 2, NodesIn: 2, NodesOut: 2},
+		{Span: Span{0, 1, "/"}, Kind: "Root", Calls: 2, NodesIn: 2, NodesOut: 2},
+		{Span: Span{1, 5, "root"}, Kind: "Step child::root", Calls: 2, NodesIn: 2, NodesOut: 2},
+		{Span: Span{6, 11, "a[@b]"}, Kind: "Step child::a", Calls: 2, NodesIn: 2, NodesOut: 2},
+		{Span: Span{7, 11, "[@b]"}, Kind: "Predicate", Calls: 2, NodesIn: 6, NodesOut: 2},
+		{Span: Span{8, 10, "@b"}, Kind: "Step attribute::b", Calls: 6, NodesIn: 6, NodesOut: 2},
+	}
+
+	report := profiler.Report()
+
+	for i := range report {
+		report[i].Total = 0
+		report[i].Self = 0
+	}
+
+	if !reflect.DeepEqual(report, expected) {
+		t.Errorf("expected %v, received %v", expected, report)
+	}
+}
+
+func TestProfilerHoisted(t *testing.T) {
+	profiler := NewProfiler()
+	err := queryXmlErr(t, context.Background(), "/root/a[. = /root/b]", `<root><a>1</a><a>2</a><a>3</a><b>2</b></root>`, func(c *ContextSettings) {
+		c.Tracer = profiler
+	})
+
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// The hoisted path is looked up for each of the 3 a's, but only
+	// evaluated once.  Both share its span, but must not be merged.
+	calls := make(map[string]int)
+
+	for _, i := range profiler.Report() {
+		calls[i.Span.Text+" "+i.Kind] += i.Calls
+	}
+
+	expected := map[string]int{
+		"/root/b Hoisted": 3,
+		"/root/b Path":    1,
+	}
+
+	for step, count := range expected {
+		if calls[step] != count {
+			t.Errorf("%s: expected %d calls, received %d", step, count, calls[step])
+		}
+	}
+}
+
+func TestSyntaxError(t *testing.T) {
+	_, err := grammar.Build("count(\n\t/a[1]]")
+	syntaxErr := &grammar.SyntaxError{}
+
+	if !errors.As(err, &syntaxErr) {
+		t.Errorf("expected a SyntaxError, received %v", err)
+		return
+	}
+
+	if syntaxErr.Line != 2 || syntaxErr.Column != 7 || syntaxErr.Offset != 13 || syntaxErr.Found != "]" {
+		t.Errorf("wrong error position: %+v", syntaxErr)
+	}
+
+	expected := []string{"'!='", "')'", "'*'", "'+'", "','", "'-'", "'/'", "'//'", "'<'", "'<='", "'='", "'>'", "'>='", "'['", "'and'", "'div'", "'mod'", "'or'", "'to'", "'|'"}
+
+	if !reflect.DeepEqual(syntaxErr.Expected, expected) {
+		t.Errorf("expected %v, received %v", expected, syntaxErr.Expected)
+	}
+
+	if snippet := syntaxErr.Snippet(); snippet != "\t/a[1]]\n\t     ^" {
+		t.Errorf("wrong snippet: %q", snippet)
+	}
+
+	_, err = grammar.Build("/a[")
+
+	if !errors.As(err, &syntaxErr) || syntaxErr.Found != "" || syntaxErr.Offset != 3 {
+		t.Errorf("expected a SyntaxError at the end of the query, received %v", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	xpath := grammar.MustBuild(`/a[$missing = foo(substring('a'))]/x:b | count($bound, 1) | y:bar() | $z:var | lib:fn()`)
+	errs := Check(&xpath, func(c *ContextSettings) {
+		c.NamespaceDecls["lib"] = "http://lib"
+		c.Variables[XmlName{Local: "bound"}] = Number(1)
+		c.FunctionLibrary[XmlName{"http://lib", "fn"}] = func(context Context, args ...Result) (Result, error) {
+			return Bool(true), nil
+		}
+	})
+
+	expected := []string{
+		"could not find variable missing, in '$missing' at offset 3",
+		"could not find function foo, in 'foo(substring('a'))' at offset 14",
+		"function substring expects 2 to 3 arguments, received 1, in 'substring('a')' at offset 18",
+		"unknown namespace binding 'x', in 'x:b' at offset 35",
+		"function count expects 1 arguments, received 2, in 'count($bound, 1)' at offset 41",
+		"unknown namespace binding 'y', in 'y:bar()' at offset 60",
+		"unknown namespace binding 'z', in '$z:var' at offset 70",
+	}
+
+	received := make([]string, 0, len(errs))
+
+	for _, i := range errs {
+		if _, ok := i.(*CheckError); !ok {
+			t.Errorf("expected a CheckError, received %v", i)
+		}
+
+		received = append(received, i.Error())
+	}
+
+	if !reflect.DeepEqual(received, expected) {
+		t.Errorf("expected:\n%s\nreceived:\n%s", strings.Join(expected, "\n"), strings.Join(received, "\n"))
+	}
+
+	valid := grammar.MustBuild(`concat(name(), 'a', string())`)
+
+	if errs := Check(&valid); errs != nil {
+		t.Errorf("expected no errors, received %v", errs)
+	}
+}
+
+func TestExprErrors(t *testing.T) {
+	xml := `<root><a>1</a></root>`
+	bar := func(c *ContextSettings) {
+		c.NamespaceDecls["x"] = "http://x"
+	}
+
+	tests := []struct {
+		expr string
+		kind error
+		name string
+		span string
+	}{
+		{"/root/a[. = $missing]", ErrUnboundVariable, "missing", "$missing"},
+		{"1 + foo(/root)", ErrUnknownFunction, "foo", "foo(/root)"},
+		{"1 + substring('a')", ErrArity, "substring", "substring('a')"},
+		{"local-name(1)", ErrNotNodeSet, "local-name", "local-name(1)"},
+		{"(1)/a", ErrNotNodeSet, "", "(1)/a"},
+		{"/root | 1", ErrNotNodeSet, "", "/root | 1"},
+		{"/root/y:a", ErrUnknownPrefix, "y", "y:a"},
+		{"x:fn(y:fn())", ErrUnknownPrefix, "y", "y:fn()"},
+	}
+
+	for _, i := range tests {
+		err := queryXmlErr(t, context.Background(), i.expr, xml, bar)
+		exprErr := &ExprError{}
+
+		if !errors.Is(err, i.kind) || !errors.As(err, &exprErr) {
+			t.Errorf("%s: expected a %s ExprError, received %v", i.expr, i.kind, err)
+			continue
+		}
+
+		if exprErr.Name != i.name || exprErr.Span.Text != i.span {
+			t.Errorf("%s: expected %s in '%s', received %s in '%s'", i.expr, i.name, i.span, exprErr.Name, exprErr.Span.Text)
+		}
+	}
+
+	xpath := grammar.MustBuild("$missing")
+
+	for _, err := range Check(&xpath) {
+		if !errors.Is(err, ErrUnboundVariable) {
+			t.Errorf("expected Check to report an unbound variable, received %v", err)
+		}
+	}
+}
+
+func TestComments(t *testing.T) {
+	xml := `<root><a>1</a><a>2</a></root>`
+
+	execXmlNodesToString(t, "/root/a (: first :)[1]", xml, "1")
+	execXml(t, "(: (: nested :) :) count(/root/a(: (::) :))", xml, Number(2))
+	execXml(t, "concat('(:', \"x:)\")", xml, String("(:x:)"))
+	execXml(t, "1 +\n(: a\ncomment :)\n2", xml, Number(3))
+
+	// Comments between the tokens of a name do not change what it means.
+	nodes := `<r>text<!-- comment --><a/></r>`
+	execXmlNodesToString(t, "/r/text (: c :) ()", nodes, "text")
+	execXml(t, "count(/r/comment (: c :) ())", nodes, Number(1))
+	execXml(t, "str (: c :) : upper-case('a')", xml, String("A"), StringsLibrary().Apply)
+	execXml(t, "concat (: c :) ('a', 'b')", xml, String("ab"))
+
+	// The lexer skips comments itself.
+	lex := lexer.New([]rune("/root (: a (: nested :) comment :) /a"))
+
+	if len(lex.Tokens) != 5 {
+		t.Errorf("expected 4 tokens and EOF, received %v", lex.Tokens)
+	}
+
+	xpath := grammar.MustBuild("(: is it bound? :) $missing")
+	errs := Check(&xpath)
+
+	if len(errs) != 1 || errs[0].(*CheckError).Span != (Span{19, 27, "$missing"}) {
+		t.Errorf("expected the span of $missing, received %v", errs)
+	}
+
+	xpath = grammar.MustBuild("count(/root (: all :))")
+	expr, _ := Compile(&xpath)
+
+	if span := expr.root.spanOf(); span.Text != "count(/root (: all :))" {
+		t.Errorf("expected the span to keep the comment, received %s", span.Text)
+	}
+
+	syntaxErrors := []struct {
+		expr   string
+		line   int
+		column int
+	}{
+		{"1 (: unterminated (: nested :)", 1, 3},
+		{"(: a\ncomment :) 1 +", 2, 15},
+	}
+
+	for _, i := range syntaxErrors {
+		_, err := grammar.Build(i.expr)
+		syntaxErr, ok := err.(*grammar.SyntaxError)
+
+		if !ok || syntaxErr.Line != i.line || syntaxErr.Column != i.column {
+			t.Errorf("%s: expected a syntax error at %d:%d, received %v", i.expr, i.line, i.column, err)
+		}
+	}
+}
+
+func TestIfExpr(t *testing.T) {
+	xml := `<root><a>1</a><a>2</a><if><then>3</then><else>4</else></if></root>`
+
+	execXml(t, "if (/root/a) then 'yes' else 'no'", xml, String("yes"))
+	execXml(t, "if (/root/b) then 'yes' else 'no'", xml, String("no"))
+	execXml(t, "if (1 = 1) then if (0) then 1 else 2 else 3", xml, Number(2))
+	execXml(t, "sum(/root/a[if (. = 1) then true() else /root/b])", xml, Number(1))
+	execXml(t, "concat(if (1) then 'a' else 'b', 'c')", xml, String("ac"))
+	execXmlNodesToString(t, "(if (/root/a) then /root/a else /root/b)[2]", xml, "2")
+	execXml(t, "sum(/root/if/then | /root/if/else)", xml, Number(7))
+
+	// The branch that is not selected is never evaluated.
+	execXml(t, "if (true()) then 1 else missing()", xml, Number(1))
+	execXml(t, "if (false()) then $missing else 2", xml, Number(2))
+
+	xpath := grammar.MustBuild("if (a) then 1 else 2")
+	expected := `If
+  Step child::a
+  Number 1
+  Number 2
+Extensions:
+  if expression
+`
+
+	if result := Explain(MustCompile(&xpath)); result != expected {
+		t.Errorf("expected:\n%s\nreceived:\n%s", expected, result)
+	}
+}
+
+func TestLetForExpr(t *testing.T) {
+	xml := `<root><a>1</a><a>2</a><a>3</a><b>1</b><b>3</b></root>`
+	x := func(c *ContextSettings) {
+		c.Variables[XmlName{Local: "x"}] = Number(10)
+	}
+
+	execXml(t, "let $x := 2 return $x * 3", xml, Number(6), x)
+	execXml(t, "(let $x := 2 return $x) + $x", xml, Number(12), x)
+	execXml(t, "let $x := $x + 1 return let $x := $x * 2 return $x", xml, Number(22), x)
+	execXml(t, "let $a := /root/a return count($a) + sum($a)", xml, Number(9))
+	execXml(t, "count(for $a in /root/a return /root/b[. = $a])", xml, Number(2))
+	execXml(t, "sum(for $b in /root/b return /root/a[. = concat($b, '')])", xml, Number(4))
+	execXml(t, "count(/root/a[for $b in /root/b return /root/a[. = $b]])", xml, Number(3))
+	execXml(t, "sum(for $x in 2 return /root/a[$x])", xml, Number(2), x)
+	execXmlNodesToString(t, "(for $b in /root/b return /root/a[. = $b])[2]", xml, "3")
+
+	first := func(c *ContextSettings) {
+		c.LazyFunctions[XmlName{Local: "first"}] = func(context Context, args ...Thunk) (Result, error) {
+			return args[0]()
+		}
+	}
+
+	execXml(t, "sum(for $a in /root/a return /root/a[. = first($a)])", xml, Number(6), first)
+
+	// Absolute paths that read a variable bound in the query, past their
+	// first step, are not hoisted out of the predicate.
+	idXml := `<root><a id="1"><b>1</b></a><a id="2"><b>2</b></a><a id="3"><b>4</b></a></root>`
+	execXmlNodesToString(t, "//a[let $i := @id return /root/a[@id = $i]/b = 4]/@id", idXml, "3")
+	execXml(t, "count(//a[let $i := @id return /root/a[@id = $i]/b = 4])", idXml, Number(1))
+	execXml(t, "count(//a[for $i in @id return (/root/a[@id = $i]/b)[. = 4]])", idXml, Number(1))
+
+	if result := queryXml(t, "for $a in /root/a return $a * 2", xml); !reflect.DeepEqual(result, Sequence{Number(2), Number(4), Number(6)}) {
+		t.Errorf("expected a Sequence of numbers, received %#v", result)
+	}
+
+	xpath := grammar.MustBuild("let $a := 1 return for $b in /root return $a + $b + $c")
+	errs := Check(&xpath)
+
+	if len(errs) != 1 || !errors.Is(errs[0], ErrUnboundVariable) || errs[0].(*CheckError).Span.Text != "$c" {
+		t.Errorf("expected only $c to be unbound, received %v", errs)
+	}
+}
+
+func TestQuantifiedExpr(t *testing.T) {
+	xml := `<order><line qty="2"/><line qty="0"/><line qty="5"/><contact/><contact primary="true"/></order>`
+
+	execXml(t, "every $l in /order/line satisfies $l/@qty > 0", xml, Bool(false))
+	execXml(t, "every $l in /order/line satisfies $l/@qty >= 0", xml, Bool(true))
+	execXml(t, "some $c in /order/contact satisfies $c/@primary = 'true'", xml, Bool(true))
+	execXml(t, "some $c in /order/contact satisfies $c/@secondary", xml, Bool(false))
+	execXml(t, "some $x in /order/missing satisfies true()", xml, Bool(false))
+	execXml(t, "every $x in /order/missing satisfies false()", xml, Bool(true))
+	execXml(t, "every $x in 3 satisfies $x = 3", xml, Bool(true))
+	execXml(t, "count(/order/line[some $l in /order/line satisfies $l/@qty > ./@qty])", xml, Number(2))
+
+	// The absolute paths read the variable, so they are evaluated again for
+	// each item rather than hoisted.
+	idXml := `<root><a id="1"><b>1</b></a><a id="2"><b>2</b></a><a id="3"><b>4</b></a></root>`
+	execXmlNodesToString(t, "//a[some $i in @id satisfies /root/a[@id = $i]/b = 4]/@id", idXml, "3")
+	execXml(t, "count(//a[every $i in @id satisfies /root/a[@id = $i]/b = 4])", idXml, Number(1))
+	execXml(t, "count(//a[every $i in @id satisfies /root/a[@id = $i]/b < 4])", idXml, Number(2))
+
+	evaluated := 0
+	counted := func(c *ContextSettings) {
+		c.FunctionLibrary[XmlName{Local: "positive"}] = func(context Context, args ...Result) (Result, error) {
+			evaluated++
+			return Bool(args[0].Number() > 0), nil
+		}
+	}
+
+	execXml(t, "every $l in /order/line satisfies positive($l/@qty)", xml, Bool(false), counted)
+
+	if evaluated != 2 {
+		t.Errorf("expected every to stop at the second line, evaluated %d", evaluated)
+	}
+
+	evaluated = 0
+	execXml(t, "some $l in /order/line satisfies positive($l/@qty)", xml, Bool(true), counted)
+
+	if evaluated != 1 {
+		t.Errorf("expected some to stop at the first line, evaluated %d", evaluated)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	xml := `<root><a>1</a><a>2</a><a>3</a><to>2</to></root>`
+	x := func(c *ContextSettings) {
+		c.Variables[XmlName{Local: "x"}] = Sequence{String("a"), Sequence{String("b")}}
+	}
+
+	sequences := []struct {
+		expr     string
+		expected Result
+	}{
+		{"(1, 'a', true())", Sequence{Number(1), String("a"), Bool(true)}},
+		{"((1, 2), (), 3)", Sequence{Number(1), Number(2), Number(3)}},
+		{"(1, ())", Sequence{Number(1)}},
+		{"()", NodeSet{}},
+		{"1 to 4", Sequence{Number(1), Number(2), Number(3), Number(4)}},
+		{"2.6 to 3.4", Sequence{Number(3)}},
+		{"3 to 1", NodeSet{}},
+		{"() to 3", NodeSet{}},
+		{"1 to /root/to", Sequence{Number(1), Number(2)}},
+		{"for $i in 1 to 3 return $i * $i", Sequence{Number(1), Number(4), Number(9)}},
+		{"2 = (1, 2, 3)", Bool(true)},
+		{"(1, 2) = (3, 4)", Bool(false)},
+		{"(1, 2) != (1, 1)", Bool(true)},
+		{"/root/a > (5, 2)", Bool(true)},
+		{"(4, 'a') + 1", Number(5)},
+		{"string(('x', 1))", String("x")},
+		{"boolean((0, 1)[2])", Bool(true)},
+		{"boolean((/root/a, 0))", Bool(true)},
+		{"not(/root/to > (1, 5))", Bool(false)},
+		{"boolean((0, ()))", Bool(false)},
+		{"some $i in (1 to 5) satisfies $i = 4", Bool(true)},
+		{"$x = 'b'", Bool(true)},
+		{"count(1 to 3)", Number(3)},
+		{"count(1 to 1)", Number(1)},
+		{"count(())", Number(0)},
+		{"count(('a', /root/a))", Number(4)},
+		{"sum(1 to 3)", Number(6)},
+		{"sum((0.5, /root/a))", Number(6.5)},
+		{"sum((1.5, 2.25))", Number(3.75)},
+		{"sum(2.5 to 2.5)", Number(3)},
+		{"(1, 2, 3)[2]", Sequence{Number(2)}},
+		{"(1, 2, 3)[. > 1]", Sequence{Number(2), Number(3)}},
+		{"(1 to 10)[position() mod 5 = 0]", Sequence{Number(5), Number(10)}},
+		{"(1 to 10)[last()]", Sequence{Number(10)}},
+		{"('a', 'b')[3]", NodeSet{}},
+		{"(1, /root/a)[1]", Sequence{Number(1)}},
+		{"if ((0, 1)[2]) then 'yes' else 'no'", String("yes")},
+	}
+
+	for _, i := range sequences {
+		if result := queryXml(t, i.expr, xml, x); !reflect.DeepEqual(result, i.expected) {
+			t.Errorf("%s: expected %#v, received %#v", i.expr, i.expected, result)
+		}
+	}
+
+	// A sequence of nodes is a NodeSet, in document order and without
+	// duplicates, so path steps and predicates treat it as one.
+	execXml(t, "count((/root/a, /root/a))", xml, Number(3))
+	execXmlNodesToString(t, "(/root/a[3], /root/a[1])", xml, "1")
+	execXmlNodesToString(t, "(/root/a[3], /root/a[1])[2]", xml, "3")
+	execXml(t, "count((/root/a[2], /root/a[2])/following-sibling::*)", xml, Number(2))
+
+	if nodeSet, ok := queryXml(t, "(/root/to, /root/a[1], /root/to)", xml).(NodeSet); !ok || len(nodeSet) != 2 || nodeSet.String() != "1" {
+		t.Errorf("expected a NodeSet of 2 nodes in document order, received %#v", nodeSet)
+	}
+	execXml(t, "count(/root/a[. = (3, /root/to)])", xml, Number(2))
+
+	execXmlNodesToString(t, "(1, /root/a)[3]", xml, "2")
+	execXmlNodesToString(t, "('x', /root/a)[. = 3]", xml, "3")
+	execXml(t, "count(('x', /root/a)[2]/following-sibling::a)", xml, Number(2))
+
+	mixed, ok := queryXml(t, "(/root/a[2], 'x')", xml).(Sequence)
+
+	if !ok || len(mixed) != 2 || mixed[0].String() != "2" || mixed[1] != String("x") {
+		t.Errorf("expected a node and a string, received %#v", mixed)
+	}
+
+	if _, ok := mixed[0].(NodeSet); !ok {
+		t.Errorf("expected the first item to be a node, received %#v", mixed[0])
+	}
+
+	// count() and sum() only accept node-sets and sequences, and a sequence
+	// of several atomic values has no boolean value.
+	for _, i := range []struct {
+		expr string
+		kind error
+	}{
+		{"count(1)", ErrNotNodeSet},
+		{"count('a')", ErrNotNodeSet},
+		{"sum(true())", ErrNotNodeSet},
+		{"boolean((0, 0))", ErrNotBoolean},
+		{"not(('a', 'b'))", ErrNotBoolean},
+		{"if ((1, 2)) then 1 else 2", ErrNotBoolean},
+		{"(1 to 3)[(1, 2)]", ErrNotBoolean},
+	} {
+		if err := queryXmlErr(t, context.Background(), i.expr, xml); !errors.Is(err, i.kind) {
+			t.Errorf("%s: expected %v, received %v", i.expr, i.kind, err)
+		}
+	}
+
+	limitErr := &LimitError{}
+	err := queryXmlErr(t, context.Background(), "1 to 100", xml, func(c *ContextSettings) { c.MaxNodeSetSize = 10 })
+
+	if !errors.As(err, &limitErr) || limitErr.Limit != LimitNodeSetSize {
+		t.Errorf("expected a LimitError, received %v", err)
+	}
+
+	// Ranges are limited even when the NodeSet size is not.
+	err = queryXmlErr(t, context.Background(), "count(1 to 1000000000000)", xml)
+
+	if !errors.As(err, &limitErr) || limitErr.Max != MaxRangeSize {
+		t.Errorf("expected a LimitError, received %v", err)
+	}
+
+	xpath := grammar.MustBuild("(1, 2 to 3, ())")
+	expected := `Sequence
+  Number 1
+  Range
+    Number 2
+    Number 3
+  Sequence
+Extensions:
+  range expression
+  sequence expression
+`
+
+	if result := Explain(MustCompile(&xpath)); result != expected {
+		t.Errorf("expected:\n%s\nreceived:\n%s", expected, result)
+	}
+}
+
+func TestExprStep(t *testing.T) {
+	xml := `<root><a>1</a><a>2</a><a>3</a><to>2</to></root>`
+
+	steps := []struct {
+		expr     string
+		expected Result
+	}{
+		{"/root/a/(. * 2)", Sequence{Number(2), Number(4), Number(6)}},
+		{"/root/a/(position())", Sequence{Number(1), Number(2), Number(3)}},
+		{"/root/a/(last())", Sequence{Number(3), Number(3), Number(3)}},
+		{"(/root/a)[. > 1]/(string(.))", Sequence{String("2"), String("3")}},
+		{"/root/a[1]/(1, 2)", Sequence{Number(1), Number(2)}},
+		{"/root/a/(())", NodeSet{}},
+		{"sum(/root/a/(. * .))", Number(14)},
+		{"count(/root/a/(following-sibling::*))", Number(3)},
+	}
+
+	for _, i := range steps {
+		if result := queryXml(t, i.expr, xml); !reflect.DeepEqual(result, i.expected) {
+			t.Errorf("%s: expected %#v, received %#v", i.expr, i.expected, result)
+		}
+	}
+
+	// Nodes are returned in document order, without duplicates.
+	execXmlNodesToString(t, "/root/a/(../to | ../a[1])", xml, "1")
+	execXml(t, "count(/root/a/(../to))", xml, Number(1))
+
+	if err := queryXmlErr(t, context.Background(), "(1, 2)/(. + 1)", xml); !errors.Is(err, ErrNotNodeSet) {
+		t.Errorf("expected ErrNotNodeSet, received %v", err)
+	}
+
+	xpath := grammar.MustBuild("a/(@b * 2)")
+	expected := `Path
+  Step child::a
+  Expression step
+    Operator *
+      Step attribute::b
+      Number 2
+Extensions:
+  parenthesised expression step
+`
+
+	if result := Explain(MustCompile(&xpath)); result != expected {
+		t.Errorf("expected:\n%s\nreceived:\n%s", expected, result)
+	}
+}
+
+func TestId(t *testing.T) {
+	xml := `<?xml version="1.0"?>
+<!DOCTYPE root [
+	<!ELEMENT root ANY>
+	<!ATTLIST section
+		title CDATA #IMPLIED
+		name ID #REQUIRED
+		kind (a|b) "a">
+]>
+<root>
+	<para xml:id="p1">first</para>
+	<section name=" s1 " title="p2">second</section>
+	<para xml:id="p2" key="k1">third</para>
+	<para xml:id="p1">duplicate</para>
+	<refs>p2 s1 missing</refs>
+</root>`
+
+	expectedIds := []struct {
+		expr     string
+		result   []string
+		settings []ContextApply
+	}{
+		{"id('p1')", []string{"first"}, nil},
+		{"id('  p2 s1 p1 ')", []string{"first", "second", "third"}, nil},
+		{"id(/root/refs)", []string{"second", "third"}, nil},
+		{"id('missing')", []string{}, nil},
+		{"id('k1 s1')", []string{"second"}, nil},
+		{"id('k1 s1')", []string{"second", "third"}, []ContextApply{func(c *ContextSettings) {
+			c.IDAttributes = append(c.IDAttributes, XmlName{Local: "key"})
+		}}},
+		{"id('p1 s1')", []string{"first"}, []ContextApply{func(c *ContextSettings) {
+			c.DTDIDs = false
+		}}},
+		{"id('p1 s1')", []string{"second"}, []ContextApply{func(c *ContextSettings) {
+			c.IDAttributes = nil
+		}}},
+	}
+
+	for _, i := range expectedIds {
+		result := make([]string, 0)
+
+		for _, n := range execXmlNodes(t, i.expr, xml, i.settings...) {
+			result = append(result, GetCursorString(n))
+		}
+
+		if !reflect.DeepEqual(result, i.result) {
+			t.Errorf("%s: expected %v, received %v", i.expr, i.result, result)
+		}
+	}
+}
+
+func TestIdSubtree(t *testing.T) {
+	xml := `<r><a xml:id="x"/><b><c xml:id="y"/></b></r>`
+	cursor, err := store.CreateInMemory(parser.ReadXml(bytes.NewBufferString(xml)))
+
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	b := grammar.MustBuild("/r/b")
+	subtree, err := Exec(cursor, &b)
+
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// Querying a subtree first must not limit the document's index to it.
+	xpath := grammar.MustBuild("id('x y')")
 
-	execXmlNodesToString(t, "string(/root)", xml, "1")
-	execXmlNodesToString(t, "/root/string()", xml, "1")
-}
+	for _, i := range []store.Cursor{subtree.(NodeSet)[0], cursor} {
+		result, err := Exec(i, &xpath)
 
-func TestFunctionConcat(t *testing.T) {
-	execXmlNodesToString(t, "concat('foo', 'bar')", ``, "foobar")
+		if err != nil {
+			t.Error(err)
+		} else if nodeSet, ok := result.(NodeSet); !ok || len(nodeSet) != 2 {
+			t.Errorf("expected 2 nodes, received %v", result)
+		}
+	}
 }
 
-func TestFunctionStartsWith(t *testing.T) {
-	execXmlNodesToString(t, "starts-with('abcd', 'ab')", ``, "true")
-	execXmlNodesToString(t, "starts-with('abcd', 'b')", ``, "false")
+// countingCursor counts the calls to Attributes on the Cursor it wraps and
+// its relatives.  It hides the store.Memoizer of the Cursor.
+type countingCursor struct {
+	cursor store.Cursor
+	calls  *int
 }
 
-func TestFunctionContains(t *testing.T) {
-	execXmlNodesToString(t, "contains('abcd', 'bc')", ``, "true")
-	execXmlNodesToString(t, "contains('abcd', 'z')", ``, "false")
-}
+func (c countingCursor) wrap(cursors []store.Cursor) []store.Cursor {
+	ret := make([]store.Cursor, 0, len(cursors))
 
-func TestFunctionSubstringBefore(t *testing.T) {
-	execXmlNodesToString(t, `substring-before("1999/04/01","/")`, ``, "1999")
-	execXmlNodesToString(t, `substring-before("1999/04/01","2")`, ``, "")
-}
+	for _, i := range cursors {
+		ret = append(ret, countingCursor{i, c.calls})
+	}
 
-func TestFunctionSubstringAfter(t *testing.T) {
-	execXmlNodesToString(t, `substring-after("1999/04/01","/")`, ``, "04/01")
-	execXmlNodesToString(t, `substring-after("1999/04/01","19")`, ``, "99/04/01")
-	execXmlNodesToString(t, `substring-after("1999/04/01","a")`, ``, "")
+	return ret
 }
 
-func TestFunctionSubstring(t *testing.T) {
-	execXmlNodesToString(t, `substring("12345", 2, 3)`, ``, "234")
-	execXmlNodesToString(t, `substring("12345", 2)`, ``, "2345")
-	execXmlNodesToString(t, `substring('abcd', -2, 5)`, ``, "ab")
-	execXmlNodesToString(t, `substring('abcd', 0)`, ``, "abcd")
-	execXmlNodesToString(t, `substring('abcd', 1, 4)`, ``, "abcd")
-	execXmlNodesToString(t, `substring("12345", 1.5, 2.6)`, ``, "234")
-	execXmlNodesToString(t, `substring("12345", 0 div 0, 3)`, ``, "")
-	execXmlNodesToString(t, `substring("12345", 1, 0 div 0)`, ``, "")
-	execXmlNodesToString(t, `substring("12345", -42, 1 div 0)`, ``, "12345")
-	execXmlNodesToString(t, `substring("12345", -1 div 0, 1 div 0)`, ``, "")
+func (c countingCursor) Pos() int                   { return c.cursor.Pos() }
+func (c countingCursor) Node() node.Node            { return c.cursor.Node() }
+func (c countingCursor) Namespaces() []store.Cursor { return c.wrap(c.cursor.Namespaces()) }
+func (c countingCursor) Children() []store.Cursor   { return c.wrap(c.cursor.Children()) }
+func (c countingCursor) Parent() store.Cursor       { return countingCursor{c.cursor.Parent(), c.calls} }
+
+func (c countingCursor) Attributes() []store.Cursor {
+	*c.calls++
+	return c.wrap(c.cursor.Attributes())
 }
 
-func TestFunctionStringLength(t *testing.T) {
-	xml := `<root>1234</root>`
+func TestIdCachedPerQuery(t *testing.T) {
+	xml := `<r><a xml:id="x"/><b><c xml:id="y"/></b></r>`
+	cursor, err := store.CreateInMemory(parser.ReadXml(bytes.NewBufferString(xml)))
 
-	execXmlNodesToString(t, "string-length(/root)", xml, "4")
-	execXmlNodesToString(t, "/root/string-length()", xml, "4")
-}
+	if err != nil {
+		t.Error(err)
+		return
+	}
 
-func TestFunctionNormalizeSpace(t *testing.T) {
-	xml := `<root>  1234   </root>`
+	calls := 0
+	xpath := grammar.MustBuild("count(id('x') | id('y') | id('x y'))")
+	result, err := Exec(countingCursor{cursor, &calls}, &xpath)
 
-	execXmlNodesToString(t, "normalize-space(/root)", xml, "1234")
-	execXmlNodesToString(t, "/root/normalize-space()", xml, "1234")
-}
+	if err != nil {
+		t.Error(err)
+	} else if result != Number(2) {
+		t.Errorf("expected 2 nodes, received %v", result)
+	}
 
-func TestFunctionTranslate(t *testing.T) {
-	execXmlNodesToString(t, `translate("bar","abc","ABC")`, ``, "BAr")
-	execXmlNodesToString(t, `translate("--aaa--","abc-","ABC")`, ``, "AAA")
+	// Without a store.Memoizer, the index is built once for the query, which
+	// reads the attributes of each of the 4 elements once.
+	if calls != 4 {
+		t.Errorf("expected the attributes to be read 4 times, received %d", calls)
+	}
 }
 
-func TestFunctionNot(t *testing.T) {
-	execXml(t, `not(1)`, ``, Bool(false))
-	execXml(t, `not(0)`, ``, Bool(true))
+// unindexedCursor hides the name index of the Cursor it wraps.
+type unindexedCursor struct {
+	cursor store.Cursor
 }
 
-func TestFunctionTrue(t *testing.T) {
-	execXml(t, `true()`, ``, Bool(true))
-}
+func unindexed(cursors []store.Cursor) []store.Cursor {
+	ret := make([]store.Cursor, 0, len(cursors))
 
-func TestFunctionFalse(t *testing.T) {
-	execXml(t, `false()`, ``, Bool(false))
+	for _, i := range cursors {
+		ret = append(ret, unindexedCursor{i})
+	}
+
+	return ret
 }
 
-func TestFunctionLang(t *testing.T) {
-	xml := `
-<p1>
-	<p xml:lang="en">I went up a floor.</p>
-	<p xml:lang="en-GB">I took the lift.</p>
-	<p xml:lang="en-US">I rode the elevator.</p>
-</p1>`
+func (u unindexedCursor) Pos() int                   { return u.cursor.Pos() }
+func (u unindexedCursor) Node() node.Node            { return u.cursor.Node() }
+func (u unindexedCursor) Namespaces() []store.Cursor { return unindexed(u.cursor.Namespaces()) }
+func (u unindexedCursor) Attributes() []store.Cursor { return unindexed(u.cursor.Attributes()) }
+func (u unindexedCursor) Children() []store.Cursor   { return unindexed(u.cursor.Children()) }
+func (u unindexedCursor) Parent() store.Cursor       { return unindexedCursor{u.cursor.Parent()} }
 
-	execXmlNodesToString(t, `count(//p[lang('en')])`, xml, "3")
-	execXmlNodesToString(t, `count(//text()[lang('en-GB')])`, xml, "1")
-	execXmlNodesToString(t, `count(//p[lang('en-US')])`, xml, "1")
-	execXmlNodesToString(t, `count(//p[lang('de')])`, xml, "0")
-	execXmlNodesToString(t, `count(/p1[lang('en')])`, xml, "0")
-}
+func TestNameIndex(t *testing.T) {
+	xml := `<r xmlns:n="http://n"><a x="1"><b>1</b><c><b>2</b></c></a>text<b>3</b><n:b>4</n:b><a><b>5</b></a></r>`
+	cursor, err := store.CreateInMemory(parser.ReadXml(bytes.NewBufferString(xml)))
 
-func TestFunctionNumber(t *testing.T) {
-	xml := `<root>1234</root>`
+	if err != nil {
+		t.Error(err)
+		return
+	}
 
-	execXml(t, "number(/root)", xml, Number(1234))
-	execXml(t, "/root/number()", xml, Number(1234))
-}
+	queries := []string{
+		"//b",
+		"//b[2]",
+		"//b[last()]",
+		"//a//b",
+		"/r/a/descendant::b",
+		"//n:b",
+		"/r/a[1]/following::b",
+		"//c/following::b",
+		"//@x/following::b",
+		"//text()/following::b",
+		"/descendant::b",
+		"count(//b)",
+	}
 
-func TestFunctionSum(t *testing.T) {
-	xml := `
-<root>
-	<a>1</a>
-	<a>2</a>
-	<a>3</a>
-</root>
-`
+	settings := func(c *ContextSettings) {
+		c.NamespaceDecls["n"] = "http://n"
+	}
 
-	execXml(t, "sum(/root/a)", xml, Number(6))
-}
+	for _, i := range queries {
+		xpath := grammar.MustBuild(i)
+		indexed, err := Exec(cursor, &xpath, settings)
 
-func TestFunctionFloor(t *testing.T) {
-	execXml(t, "floor(2.2)", ``, Number(2))
-}
+		if err != nil {
+			t.Error(err)
+			continue
+		}
 
-func TestFunctionCeiling(t *testing.T) {
-	execXml(t, "ceiling(2.2)", ``, Number(3))
+		plain, err := Exec(unindexedCursor{cursor}, &xpath, settings)
+
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		if indexed.String() != plain.String() || indexed.Number() != plain.Number() {
+			t.Errorf("%s: indexed result %s differs from %s", i, indexed, plain)
+		}
+
+		indexedNodes, _ := indexed.(NodeSet)
+		plainNodes, _ := plain.(NodeSet)
+
+		if len(indexedNodes) != len(plainNodes) {
+			t.Errorf("%s: indexed result has %d nodes, expected %d", i, len(indexedNodes), len(plainNodes))
+			continue
+		}
+
+		for n := range indexedNodes {
+			if indexedNodes[n].Pos() != plainNodes[n].Pos() {
+				t.Errorf("%s: node %d differs", i, n)
+			}
+		}
+	}
 }
 
-func TestFunctionRound(t *testing.T) {
-	execXml(t, `round(-1.5)`, ``, Number(-2))
-	execXml(t, `round(1.5)`, ``, Number(2))
-	execXml(t, `round(0)`, ``, Number(0))
+func TestStreaming(t *testing.T) {
+	xml := `<r><a x="1"><b>1</b><c><b>2</b></c></a>text<b>3</b><a><b>4</b><b>5</b></a></r>`
+	cursor, err := store.CreateInMemory(parser.ReadXml(bytes.NewBufferString(xml)))
+
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	queries := []string{
+		"//b[1]",
+		"(//b)[2]",
+		"//a[2]/b",
+		"//a/b[1]",
+		"/r/a/@x",
+		"/r/a[b]",
+		"//a[c/b]",
+		"//*[.//b][2]",
+		"/r/a[1]/descendant-or-self::node()[3]",
+		"//c/ancestor::*[1]",
+		"//b[1] | //c",
+		"boolean(//c)",
+		"not(//d)",
+		"//d or //c",
+		"//c and //d",
+		"/r/a/b[. = 4]",
+		"r/a/b[1.5]",
+		"//b[last()]",
+		"/r/a/b[position() = last() - 1]",
+		"//b[last()][1]",
+	}
+
+	for _, i := range queries {
+		xpath := grammar.MustBuild(i)
+		streamed, err := Exec(cursor, &xpath)
+
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		// The Tracer needs every intermediate NodeSet, so it turns streaming off.
+		materialised, err := Exec(cursor, &xpath, func(c *ContextSettings) {
+			c.Tracer = &recordingTracer{}
+		})
+
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		if !reflect.DeepEqual(streamed, materialised) {
+			t.Errorf("%s: streamed result %v differs from %v", i, streamed, materialised)
+		}
+	}
 }
 
-func TestCustomFunction(t *testing.T) {
-	xml := `
-<root>
-	<a>5</a>
-	<b>2.5</b>
-	<c>6</c>
-</root>
-`
-	variables := func(c *ContextSettings) {
-		c.NamespaceDecls["foo"] = "http://root"
-		c.FunctionLibrary[XmlName{"http://root", "bar"}] = func(context Context, args ...Result) (Result, error) {
-			return Number(2.5), nil
+func TestEarlyTermination(t *testing.T) {
+	xml := "<r>" + strings.Repeat("<a><b/></a>", 100) + "</r>"
+	limit := func(c *ContextSettings) {
+		c.MaxNodesVisited = 10
+	}
+
+	for _, i := range []string{"//a[1]", "(//a)[1]", "/r/a[2]/b", "boolean(//b)", "not(//a)", "//b or //c", "/r/a[b][1]"} {
+		if err := queryXmlErr(t, context.Background(), i, xml, limit); err != nil {
+			t.Errorf("%s: %v", i, err)
 		}
 	}
 
-	nodes := execXmlNodes(t, "//*[. = foo:bar()]", xml, variables)
+	var limitErr *LimitError
 
-	if len(nodes) != 1 {
-		t.Error("Size is not 1")
+	if err := queryXmlErr(t, context.Background(), "count(//a)", xml, limit); !errors.As(err, &limitErr) {
+		t.Errorf("expected a LimitError, received %v", err)
 	}
 
-	b := nodes[0]
+	cursor, err := store.CreateInMemory(parser.ReadXml(bytes.NewBufferString(xml)))
 
-	if (store.Cursor)(b).Node().(node.Element).Local() != "b" {
-		t.Error("Node not 'b'")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	xpath := grammar.MustBuild("//b")
+	first, err := ExecFirst(cursor, &xpath, limit)
+
+	if err != nil {
+		t.Error(err)
+	} else if nodeSet, ok := first.(NodeSet); !ok || len(nodeSet) != 1 {
+		t.Errorf("expected the first node, received %v", first)
 	}
 }
 
-func TestVariableReference(t *testing.T) {
-	xml := `
-<root>
-	<a>5</a>
-	<b>2.5</b>
-	<c>6</c>
-</root>
-`
-	variables := func(c *ContextSettings) {
-		c.NamespaceDecls["foo"] = "http://root"
-		c.Variables[XmlName{"http://root", "bar"}] = Number(2.5)
+func TestIterate(t *testing.T) {
+	cursor, err := store.CreateInMemory(parser.ReadXml(bytes.NewBufferString(`<r><a>1</a><b><a>2</a></b><a>3</a><a>4</a></r>`)))
+
+	if err != nil {
+		t.Error(err)
+		return
 	}
 
-	nodes := execXmlNodes(t, "//*[. = $foo:bar]", xml, variables)
+	xpath := grammar.MustBuild("//a")
+	values := make([]string, 0)
+	err = Iterate(cursor, MustCompile(&xpath), func(c store.Cursor) bool {
+		values = append(values, GetCursorString(c))
+		return len(values) < 3
+	})
 
-	if len(nodes) != 1 {
-		t.Error("Size is not 1")
+	if err != nil {
+		t.Error(err)
 	}
 
-	b := nodes[0]
+	if !reflect.DeepEqual(values, []string{"1", "2", "3"}) {
+		t.Errorf("unexpected nodes %v", values)
+	}
 
-	if (store.Cursor)(b).Node().(node.Element).Local() != "b" {
-		t.Error("Node not 'b'")
+	xpath = grammar.MustBuild("count(//a)")
+	err = Iterate(cursor, MustCompile(&xpath), func(c store.Cursor) bool {
+		return true
+	})
+
+	if err == nil {
+		t.Error("expected an error for a query that does not return a NodeSet")
 	}
 }
 
-func TestNewContext(t *testing.T) {
-	xml := `<root><a>5</a><b>2.5</b><c>6</c></root>`
-
-	xpath, err := grammar.Build("a[1]")
+func TestHoisting(t *testing.T) {
+	xml := `<root><config currency="EUR"/>` + strings.Repeat(`<order currency="EUR"/><order currency="USD"/>`, 50) + `</root>`
+	cursor, err := store.CreateInMemory(parser.ReadXml(bytes.NewBufferString(xml)))
 
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
-	parser := parser.ReadXml(bytes.NewBufferString(xml))
-	cursor, err := store.CreateInMemory(parser)
+	xpath := grammar.MustBuild("//order[@currency = /root/config/@currency]")
+	expr := MustCompile(&xpath)
+	result, err := ExecExpr(cursor, expr, func(c *ContextSettings) {
+		c.MaxNodesVisited = 500
+	})
 
 	if err != nil {
 		t.Error(err)
-		return
+	} else if nodeSet, ok := result.(NodeSet); !ok || len(nodeSet) != 50 {
+		t.Errorf("expected 50 orders, received %v", result)
+	}
+
+	expected := `Path
+  Root
+  Step descendant::order
+    Predicate
+      Operator =
+        Step attribute::currency
+        Hoisted
+          Path
+            Root
+            Step child::root
+            Step child::config
+            Step attribute::currency
+`
+
+	if explain := Explain(expr); explain != expected {
+		t.Errorf("expected:\n%s\nreceived:\n%s", expected, explain)
 	}
 
-	result, err := Exec(cursor.Children()[0], &xpath)
+	// Replacing a built-in function stops its calls from being hoisted.
+	calls := 0
+	xpath = grammar.MustBuild("//order[count(/root/config) = 1]")
+	_, err = Exec(cursor, &xpath, func(c *ContextSettings) {
+		c.FunctionLibrary[XmlName{Local: "count"}] = func(context Context, args ...Result) (Result, error) {
+			calls++
+			return count(context, args...)
+		}
+	})
 
 	if err != nil {
 		t.Error(err)
 	}
 
-	if result.String() != "5" {
-		t.Errorf("Result != '5'")
+	if calls != 100 {
+		t.Errorf("expected count() to be called for each order, called %d times", calls)
+	}
+
+	// Hoisted expressions are traced, and count towards MaxDepth, the first
+	// time they are evaluated, like any other expression.
+	tracer := &recordingTracer{}
+	xpath = grammar.MustBuild("/root/order[@currency = /root/config/@currency][1]")
+	_, err = Exec(cursor, &xpath, func(c *ContextSettings) {
+		c.Tracer = tracer
+	})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	hoisted := make([]string, 0)
+
+	for _, i := range tracer.events {
+		if strings.Contains(i, " 24:46 ") {
+			hoisted = append(hoisted, i)
+		}
+	}
+
+	// The path is evaluated inside the Hoisted expression the first time, and
+	// its result is reused after that.
+	expectedEvents := []string{
+		"5 24:46 /root/config/@currency 1 1",
+		"4 24:46 /root/config/@currency 1 1",
+		"4 24:46 /root/config/@currency 1 1",
+	}
+
+	if len(hoisted) < 3 || !reflect.DeepEqual(hoisted[:3], expectedEvents) {
+		t.Errorf("expected %v, received %v", expectedEvents, hoisted)
 	}
 }
 
@@ -1665,3 +3310,90 @@ func TestUnmarshal(t *testing.T) {
 		t.Error("incorrect result")
 	}
 }
+
+func TestUnmarshalSequence(t *testing.T) {
+	type Order struct {
+		Prices []float64 `xsel:"for $p in line/@price return $p * 1.5"`
+		Names  []string  `xsel:"(line/@name, 'shipping')"`
+		Total  float64   `xsel:"sum(line/@price)"`
+	}
+
+	xml := `<order><line name="a" price="2"/><line name="b" price="4"/></order>`
+	nodes := execXmlNodes(t, "/order", xml)
+	order := Order{}
+
+	if err := Unmarshal(nodes, &order); err != nil {
+		t.Error(err)
+	}
+
+	expected := Order{
+		Prices: []float64{3, 6},
+		Names:  []string{"a", "b", "shipping"},
+		Total:  6,
+	}
+
+	if !reflect.DeepEqual(expected, order) {
+		t.Errorf("expected %v, received %v", expected, order)
+	}
+
+	numbers := []int{}
+
+	if err := Unmarshal(queryXml(t, "1 to 3", xml), &numbers); err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(numbers, []int{1, 2, 3}) {
+		t.Errorf("expected 1 to 3, received %v", numbers)
+	}
+}
+
+func TestUnmarshalDocumentRoot(t *testing.T) {
+	type Item struct {
+		Name  string `xsel:"@name"`
+		Total int    `xsel:"count(/root/items/item)"`
+	}
+
+	xml := `
+<root>
+	<items><item name="a"/><item name="b"/></items>
+</root>
+`
+	nodes := execXmlNodes(t, "/root/items/item", xml)
+	items := []Item{}
+
+	if err := Unmarshal(nodes, &items); err != nil {
+		t.Error(err)
+	}
+
+	expected := []Item{{"a", 2}, {"b", 2}}
+
+	if !reflect.DeepEqual(expected, items) {
+		t.Errorf("expected %v, received %v", expected, items)
+	}
+}
+
+func TestDocumentRoot(t *testing.T) {
+	xml := `<root><a><b/></a><c/></root>`
+	nodes := execXmlNodes(t, "/root/a", xml)
+	xpath := grammar.MustBuild("concat(name(/*), name(*), count(//c), position(), last())")
+	settings := func(c *ContextSettings) {
+		c.DocumentRoot = nodes[0].Parent().Parent()
+		c.Position = 2
+		c.Size = 3
+	}
+
+	result, err := Exec(nodes[0], &xpath, settings)
+
+	if err != nil {
+		t.Error(err)
+	} else if result != String("rootb123") {
+		t.Errorf("expected rootb123, received %s", result)
+	}
+
+	// Without a DocumentRoot, the context node is the root.
+	result, _ = Exec(nodes[0], &xpath)
+
+	if result != String("bb011") {
+		t.Errorf("expected bb011, received %s", result)
+	}
+}
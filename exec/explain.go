@@ -0,0 +1,63 @@
+package exec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Explain describes how a compiled XPath query will be evaluated.  Each line
+// of the result is a node in the expression tree, indented beneath its parent:
+// location steps with their axis, node test and predicates, function calls
+// with their arity, and operators with their operands.  If the query relies on
+// any of the xsel extensions to XPath 1.0, they are listed at the end.
+func Explain(expr *Expr) string {
+	x := &explainer{
+		seen: make(map[string]bool),
+	}
+
+	expr.root.explain(x)
+
+	if len(x.extensions) > 0 {
+		x.buf.WriteString("Extensions:\n")
+
+		for _, i := range x.extensions {
+			x.buf.WriteString("  ")
+			x.buf.WriteString(i)
+			x.buf.WriteString("\n")
+		}
+	}
+
+	return x.buf.String()
+}
+
+type explainer struct {
+	buf        strings.Builder
+	depth      int
+	extensions []string
+	seen       map[string]bool
+}
+
+func (x *explainer) line(format string, args ...any) {
+	x.buf.WriteString(strings.Repeat("  ", x.depth))
+	x.buf.WriteString(fmt.Sprintf(format, args...))
+	x.buf.WriteString("\n")
+}
+
+func (x *explainer) children(children ...exprNode) {
+	x.depth++
+
+	for _, i := range children {
+		i.explain(x)
+	}
+
+	x.depth--
+}
+
+func (x *explainer) extension(format string, args ...any) {
+	extension := fmt.Sprintf(format, args...)
+
+	if !x.seen[extension] {
+		x.seen[extension] = true
+		x.extensions = append(x.extensions, extension)
+	}
+}
@@ -12,15 +12,37 @@ import (
 
 type Function func(context Context, args ...Result) (Result, error)
 
-type overloadHelper map[int]Function
+// Thunk is an argument of a LazyFunction that has not been evaluated yet.
+// The first call evaluates it in the caller's context, and later calls return
+// the same result.
+type Thunk func() (Result, error)
+
+// LazyFunction is like Function, except its arguments are only evaluated if,
+// and when, it calls their Thunks.
+type LazyFunction func(context Context, args ...Thunk) (Result, error)
+
+func newThunk(context *exprContext, expr exprNode) Thunk {
+	var result Result
+	var err error
+	evaluated := false
+
+	return func() (Result, error) {
+		if !evaluated {
+			evaluated = true
+			result, err = execIndependent(context, expr)
+		}
+
+		return result, err
+	}
+}
 
-var errBadArgs = fmt.Errorf("incorrect number of arguments")
+type overloadHelper map[int]Function
 
 func (o overloadHelper) dispatch(context Context, args ...Result) (Result, error) {
 	fn := o[len(args)]
 
 	if fn == nil {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	return fn(context, args...)
@@ -84,6 +106,7 @@ var builtinFunctions = map[XmlName]Function{
 	{"", "string-length"}:    stringLengthDispatch.build(),
 	{"", "normalize-space"}:  normalizeSpaceDispatch.build(),
 	{"", "translate"}:        translate,
+	{"", "boolean"}:          boolean,
 	{"", "not"}:              not,
 	{"", "true"}:             true0,
 	{"", "false"}:            false0,
@@ -93,16 +116,63 @@ var builtinFunctions = map[XmlName]Function{
 	{"", "floor"}:            floor,
 	{"", "ceiling"}:          ceiling,
 	{"", "round"}:            round,
+	{"", "id"}:               id,
 }
 
-func last(context Context, args ...Result) (Result, error) {
-	nodeSet, ok := context.Result().(NodeSet)
+// arity is the range of argument counts a function accepts.  A max of -1
+// means there is no upper bound.
+type arity struct {
+	min, max int
+}
 
-	if !ok {
-		return nil, errQueryNonNodeset
+func (a arity) accepts(count int) bool {
+	return count >= a.min && (a.max < 0 || count <= a.max)
+}
+
+func (a arity) String() string {
+	switch {
+	case a.max < 0:
+		return fmt.Sprintf("at least %d", a.min)
+	case a.min == a.max:
+		return fmt.Sprintf("%d", a.min)
+	}
+
+	return fmt.Sprintf("%d to %d", a.min, a.max)
+}
+
+// contextArities lists the built-in functions that read the context, at the
+// argument counts they do.
+var contextArities = map[XmlName]arity{
+	{"", "last"}:            {0, 0},
+	{"", "position"}:        {0, 0},
+	{"", "local-name"}:      {0, 0},
+	{"", "namespace-uri"}:   {0, 0},
+	{"", "name"}:            {0, 0},
+	{"", "string"}:          {0, 0},
+	{"", "string-length"}:   {0, 0},
+	{"", "normalize-space"}: {0, 0},
+	{"", "number"}:          {0, 0},
+	{"", "lang"}:            {1, 1},
+}
+
+// contextFree reports whether a call to a built-in function only depends on
+// its arguments.
+func contextFree(name XmlName, args int) bool {
+	sig, ok := builtinSignatures[name]
+
+	if !ok || !sig.arity().accepts(args) {
+		return false
+	}
+
+	if contextArity, ok := contextArities[name]; ok {
+		return !contextArity.accepts(args)
 	}
 
-	return Number(len(nodeSet)) + 1, nil
+	return true
+}
+
+func last(context Context, args ...Result) (Result, error) {
+	return Number(context.ContextSize()), nil
 }
 
 func position(context Context, args ...Result) (Result, error) {
@@ -111,16 +181,31 @@ func position(context Context, args ...Result) (Result, error) {
 
 func count(context Context, args ...Result) (Result, error) {
 	if len(args) != 1 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
-	nodeSet, ok := args[0].(NodeSet)
+	if nodeSet, ok := args[0].(NodeSet); ok {
+		return Number(len(nodeSet)), nil
+	}
 
-	if !ok {
-		return nil, errQueryNonNodeset
+	items, err := sequenceItems(args[0])
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Number(len(items)), nil
+}
+
+// sequenceItems returns the items of a NodeSet or Sequence argument.  Like
+// XPath 1.0, count() and sum() reject strings, numbers and booleans.
+func sequenceItems(arg Result) (Sequence, error) {
+	switch arg.(type) {
+	case NodeSet, Sequence:
+		return Items(arg), nil
 	}
 
-	return Number(len(nodeSet)), nil
+	return nil, errorf(ErrNotNodeSet, "expected a node-set or sequence, received a %s", typeOf(arg))
 }
 
 type nameType int
@@ -169,7 +254,7 @@ func name1(context Context, args ...Result) (Result, error) {
 
 func getName(nodeSet NodeSet, ok bool, nameType nameType) (Result, error) {
 	if !ok {
-		return nil, errQueryNonNodeset
+		return nil, ErrNotNodeSet
 	}
 
 	if len(nodeSet) == 0 {
@@ -213,7 +298,7 @@ func concat(context Context, args ...Result) (Result, error) {
 
 func startsWith(context Context, args ...Result) (Result, error) {
 	if len(args) != 2 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	str := args[0].String()
@@ -224,7 +309,7 @@ func startsWith(context Context, args ...Result) (Result, error) {
 
 func contains(context Context, args ...Result) (Result, error) {
 	if len(args) != 2 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	str := args[0].String()
@@ -235,7 +320,7 @@ func contains(context Context, args ...Result) (Result, error) {
 
 func substringBefore(context Context, args ...Result) (Result, error) {
 	if len(args) != 2 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	str := args[0].String()
@@ -252,7 +337,7 @@ func substringBefore(context Context, args ...Result) (Result, error) {
 
 func substringAfter(context Context, args ...Result) (Result, error) {
 	if len(args) != 2 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	str := args[0].String()
@@ -269,7 +354,7 @@ func substringAfter(context Context, args ...Result) (Result, error) {
 
 func substring(context Context, args ...Result) (Result, error) {
 	if len(args) != 2 && len(args) != 3 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	str := args[0].String()
@@ -323,7 +408,7 @@ func normalizeSpace1(context Context, args ...Result) (Result, error) {
 
 func translate(context Context, args ...Result) (Result, error) {
 	if len(args) != 3 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	src := args[0].String()
@@ -343,17 +428,27 @@ func translate(context Context, args ...Result) (Result, error) {
 	return String(src), nil
 }
 
+func boolean(context Context, args ...Result) (Result, error) {
+	if len(args) != 1 {
+		return nil, ErrArity
+	}
+
+	b, err := effectiveBool(args[0])
+	return Bool(b), err
+}
+
 func not(context Context, args ...Result) (Result, error) {
 	if len(args) != 1 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
-	return Bool(!args[0].Bool()), nil
+	b, err := effectiveBool(args[0])
+	return Bool(!b), err
 }
 
 func true0(context Context, args ...Result) (Result, error) {
 	if len(args) != 0 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	return Bool(true), nil
@@ -361,7 +456,7 @@ func true0(context Context, args ...Result) (Result, error) {
 
 func false0(context Context, args ...Result) (Result, error) {
 	if len(args) != 0 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	return Bool(false), nil
@@ -369,13 +464,13 @@ func false0(context Context, args ...Result) (Result, error) {
 
 func lang(context Context, args ...Result) (Result, error) {
 	if len(args) != 1 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	nodeSet, ok := context.Result().(NodeSet)
 
 	if !ok {
-		return nil, errQueryNonNodeset
+		return nil, ErrNotNodeSet
 	}
 
 	lStr := args[0].String()
@@ -389,12 +484,12 @@ func lang(context Context, args ...Result) (Result, error) {
 			n = i.Parent()
 		}
 
-		for n.Pos() != 0 {
+		for n != nil && n.Pos() != 0 {
 			if attr, ok := store.GetAttribute(n, "http://www.w3.org/XML/1998/namespace", "lang"); ok {
 				return checkLang(lStr, attr.AttributeValue()), nil
 			}
 
-			n = n.Parent()
+			n = parentOf(n)
 		}
 	}
 
@@ -430,19 +525,20 @@ func number1(context Context, args ...Result) (Result, error) {
 
 func sum(context Context, args ...Result) (Result, error) {
 	if len(args) != 1 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
-	nodeSet, ok := args[0].(NodeSet)
+	items, err := sequenceItems(args[0])
 
-	if !ok {
-		return nil, errQueryNonNodeset
+	if err != nil {
+		return nil, err
 	}
 
-	sum := 0
+	// The items are added as floating point numbers, so fractions are kept.
+	sum := 0.0
 
-	for _, i := range nodeSet {
-		sum += int(NodeSet{i}.Number())
+	for _, n := range numbers(items) {
+		sum += n
 	}
 
 	return Number(sum), nil
@@ -450,7 +546,7 @@ func sum(context Context, args ...Result) (Result, error) {
 
 func floor(context Context, args ...Result) (Result, error) {
 	if len(args) != 1 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	return Number(math.Floor(float64(args[0].Number()))), nil
@@ -458,7 +554,7 @@ func floor(context Context, args ...Result) (Result, error) {
 
 func ceiling(context Context, args ...Result) (Result, error) {
 	if len(args) != 1 {
-		return nil, errQueryNonNodeset
+		return nil, ErrNotNodeSet
 	}
 
 	return Number(math.Ceil(float64(args[0].Number()))), nil
@@ -466,7 +562,7 @@ func ceiling(context Context, args ...Result) (Result, error) {
 
 func round(context Context, args ...Result) (Result, error) {
 	if len(args) != 1 {
-		return nil, errBadArgs
+		return nil, ErrArity
 	}
 
 	return Number(getRound(float64(args[0].Number()))), nil
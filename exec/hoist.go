@@ -0,0 +1,229 @@
+package exec
+
+// hoistedExpr is a subexpression of a predicate that does not depend on the
+// context, such as an absolute path.  It is evaluated the first time the
+// predicate is, and its result is reused for the rest of the query.
+type hoistedExpr struct {
+	expr exprNode
+	// functions lists the built-in functions expr calls.  If a custom
+	// function replaces any of them, expr is evaluated every time.
+	functions []XmlName
+}
+
+func (e *hoistedExpr) exec(context *exprContext) error {
+	state := context.state
+
+	if result, ok := state.hoisted[e]; ok {
+		context.result = result
+		return nil
+	}
+
+	for _, i := range e.functions {
+		if context.isCustom(i) {
+			return execContext(context, e.expr)
+		}
+	}
+
+	if err := execContext(context, e.expr); err != nil {
+		return err
+	}
+
+	if nodeSet, ok := context.result.(NodeSet); ok {
+		// Keep anyone appending to the NodeSet from writing into the cache.
+		context.result = nodeSet[:len(nodeSet):len(nodeSet)]
+	}
+
+	if state.hoisted == nil {
+		state.hoisted = make(map[*hoistedExpr]Result)
+	}
+
+	state.hoisted[e] = context.result
+	return nil
+}
+
+func (e *hoistedExpr) explain(x *explainer) {
+	x.line("Hoisted")
+	x.children(e.expr)
+}
+
+func (e *hoistedExpr) spanOf() *Span {
+	return e.expr.spanOf()
+}
+
+// hoister finds the subexpressions of a predicate that do not depend on the
+// context, and wraps them in hoistedExpr's.
+type hoister struct {
+	// calls lists the built-in functions called by the subexpressions
+	// visited so far, in the order they were visited.
+	calls []XmlName
+}
+
+// hoistPredicate wraps the context-independent subexpressions of a predicate.
+func hoistPredicate(expr exprNode) exprNode {
+	h := &hoister{}
+
+	if h.hoist(expr) {
+		return h.wrap(expr, 0, len(h.calls))
+	}
+
+	return expr
+}
+
+// hoist reports whether expr is independent of the context.  If it is not,
+// its independent subexpressions are wrapped in place.
+func (h *hoister) hoist(expr exprNode) bool {
+	switch e := expr.(type) {
+	case *literalExpr, *rootExpr, *hoistedExpr:
+		return true
+	case *variableReferenceExpr:
+		// Variables bound in the query change as it is evaluated.
+		return !e.bound
+	case *pathExpr:
+		// The rest of the steps only read the result of the first, and the
+		// variables bound in the query.
+		return h.children(!readsScope(e.steps[1:]...), &e.steps[0])
+	case *filterExpr:
+		return h.children(!readsScope(e.predicates...), &e.primary)
+	case *negateExpr:
+		return h.children(true, &e.operand)
+	case *binaryExpr:
+		return h.children(true, &e.left, &e.right)
+	case *logicalExpr:
+		return h.children(true, &e.left, &e.right)
+	case *ifExpr:
+		return h.children(true, &e.cond, &e.then, &e.els)
+	case *letExpr:
+		return h.children(true, &e.value, &e.body)
+	case *forExpr:
+		return h.children(true, &e.in, &e.body)
+	case *quantifiedExpr:
+		return h.children(true, &e.in, &e.test)
+	case *sequenceExpr:
+		items := make([]*exprNode, len(e.items))
+
+		for i := range e.items {
+			items[i] = &e.items[i]
+		}
+
+		return h.children(true, items...)
+	case *rangeExpr:
+		return h.children(true, &e.from, &e.to)
+	case *functionCallExpr:
+		pure := contextFree(e.name, len(e.args))
+
+		if pure {
+			h.calls = append(h.calls, e.name)
+		}
+
+		args := make([]*exprNode, len(e.args))
+
+		for i := range e.args {
+			args[i] = &e.args[i]
+		}
+
+		return h.children(pure, args...)
+	}
+
+	return false
+}
+
+// readsScope reports whether any of the expressions, or the expressions nested
+// in them, read a variable bound in the query.
+func readsScope(exprs ...exprNode) bool {
+	for _, expr := range exprs {
+		var children []exprNode
+
+		switch e := expr.(type) {
+		case *variableReferenceExpr:
+			if e.bound {
+				return true
+			}
+		case *hoistedExpr:
+			children = []exprNode{e.expr}
+		case *pathExpr:
+			children = e.steps
+		case *stepExpr:
+			children = e.predicates
+		case *exprStepExpr:
+			children = []exprNode{e.expr}
+		case *filterExpr:
+			children = append([]exprNode{e.primary}, e.predicates...)
+		case *predicateExpr:
+			children = []exprNode{e.expr}
+		case *negateExpr:
+			children = []exprNode{e.operand}
+		case *binaryExpr:
+			children = []exprNode{e.left, e.right}
+		case *logicalExpr:
+			children = []exprNode{e.left, e.right}
+		case *ifExpr:
+			children = []exprNode{e.cond, e.then, e.els}
+		case *letExpr:
+			children = []exprNode{e.value, e.body}
+		case *forExpr:
+			children = []exprNode{e.in, e.body}
+		case *quantifiedExpr:
+			children = []exprNode{e.in, e.test}
+		case *sequenceExpr:
+			children = e.items
+		case *rangeExpr:
+			children = []exprNode{e.from, e.to}
+		case *functionCallExpr:
+			children = e.args
+		}
+
+		if readsScope(children...) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// children hoists each child.  If they, and their parent, are all
+// independent, it is left to the parent's caller to wrap the parent.
+// Otherwise, the independent children are wrapped.
+func (h *hoister) children(independent bool, children ...*exprNode) bool {
+	starts := make([]int, len(children))
+	results := make([]bool, len(children))
+
+	for i, child := range children {
+		starts[i] = len(h.calls)
+		results[i] = h.hoist(*child)
+		independent = independent && results[i]
+	}
+
+	if independent {
+		return true
+	}
+
+	for i, child := range children {
+		if !results[i] {
+			continue
+		}
+
+		end := len(h.calls)
+
+		if i+1 < len(children) {
+			end = starts[i+1]
+		}
+
+		*child = h.wrap(*child, starts[i], end)
+	}
+
+	return false
+}
+
+// wrap wraps expr, whose calls are calls[start:end], unless it is cheaper to
+// evaluate it again.
+func (h *hoister) wrap(expr exprNode, start, end int) exprNode {
+	switch expr.(type) {
+	case *literalExpr, *variableReferenceExpr, *rootExpr, *hoistedExpr:
+		return expr
+	}
+
+	functions := make([]XmlName, end-start)
+	copy(functions, h.calls[start:end])
+
+	return &hoistedExpr{expr: expr, functions: functions}
+}
@@ -0,0 +1,127 @@
+package exec
+
+import (
+	"strings"
+
+	"github.com/ChrisTrenkamp/xsel/node"
+	"github.com/ChrisTrenkamp/xsel/store"
+)
+
+var xmlIDName = XmlName{Space: "http://www.w3.org/XML/1998/namespace", Local: "id"}
+
+// idIndexKey identifies an ID index in a store.Memoizer.  Documents are
+// indexed separately for every combination of ID settings.
+type idIndexKey struct {
+	attributes string
+	dtd        bool
+}
+
+// idIndex maps ID's to the first element in the document that carries them.
+type idIndex map[string]store.Cursor
+
+func id(context Context, args ...Result) (Result, error) {
+	if len(args) != 1 {
+		return nil, ErrArity
+	}
+
+	ids := make([]string, 0)
+
+	if nodeSet, ok := args[0].(NodeSet); ok {
+		for _, i := range nodeSet {
+			ids = append(ids, strings.Fields(GetCursorString(i))...)
+		}
+	} else {
+		ids = strings.Fields(args[0].String())
+	}
+
+	index := context.(*exprContext).idIndex()
+	ret := make(NodeSet, 0, len(ids))
+
+	for _, i := range ids {
+		if element, ok := index[i]; ok {
+			ret = append(ret, element)
+		}
+	}
+
+	return unionCleanup(ret), nil
+}
+
+// idIndex returns the ID's of the document being queried.  If the document
+// is a store.Memoizer, the index is only built once per document.  Otherwise,
+// it is built once per query.
+func (c *exprContext) idIndex() idIndex {
+	names := make([]string, 0, len(c.IDAttributes))
+
+	for _, i := range c.IDAttributes {
+		names = append(names, i.String())
+	}
+
+	key := idIndexKey{
+		attributes: strings.Join(names, " "),
+		dtd:        c.DTDIDs,
+	}
+
+	// The query may have been given a node in the middle of the document, but
+	// the index is shared by every query against it.
+	root := documentRoot(c.root)
+
+	build := func() any {
+		index := make(idIndex)
+		c.buildIDIndex(index, root)
+		return index
+	}
+
+	if memo, ok := root.(store.Memoizer); ok {
+		return memo.Memo(key, build).(idIndex)
+	}
+
+	state := c.state
+
+	if index, ok := state.ids[key]; ok {
+		return index
+	}
+
+	if state.ids == nil {
+		state.ids = make(map[idIndexKey]idIndex)
+	}
+
+	index := build().(idIndex)
+	state.ids[key] = index
+	return index
+}
+
+func (c *exprContext) buildIDIndex(index idIndex, cursor store.Cursor) {
+	if _, ok := cursor.Node().(node.Element); ok {
+		for _, i := range cursor.Attributes() {
+			if !c.isID(i.Node().(node.Attribute)) {
+				continue
+			}
+
+			value := strings.TrimSpace(i.Node().(node.Attribute).AttributeValue())
+
+			if _, exists := index[value]; !exists {
+				index[value] = cursor
+			}
+		}
+	}
+
+	for _, i := range cursor.Children() {
+		c.buildIDIndex(index, i)
+	}
+}
+
+func (c *exprContext) isID(attr node.Attribute) bool {
+	if c.DTDIDs {
+		if idAttr, ok := attr.(node.IDAttribute); ok && idAttr.IsID() {
+			return true
+		}
+	}
+
+	for _, i := range c.IDAttributes {
+		if attr.Space() == i.Space && attr.Local() == i.Local {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,389 @@
+package exec
+
+import (
+	"github.com/ChrisTrenkamp/xsel/store"
+)
+
+// nodeIterator pulls nodes one at a time.  next returns a nil Cursor once
+// there are no nodes left.
+type nodeIterator interface {
+	next() (store.Cursor, error)
+}
+
+// nodeStream is a nodeIterator, along with what is known about the order of
+// its nodes.  A step uses it to decide whether it can stream its own nodes, or
+// has to gather, sort and de-duplicate them first.
+type nodeStream struct {
+	nodeIterator
+	// sorted is set when the nodes are in document order, without duplicates.
+	sorted bool
+	// flat is set when no node is the ancestor of another.
+	flat bool
+}
+
+// pipeExpr is implemented by the expressions that select nodes from a stream
+// of context nodes, such as location steps and predicates.
+type pipeExpr interface {
+	exprNode
+	pipe(context *exprContext, input nodeStream) (nodeStream, error)
+}
+
+// streamer is implemented by the expressions that can return their NodeSet
+// as a stream, when streams reports it.  stream reads the context nodes from
+// the context, like exec.
+type streamer interface {
+	exprNode
+	streams() bool
+	stream(context *exprContext) (nodeStream, error)
+}
+
+// streaming reports whether NodeSets are streamed.  The Tracer is told the
+// size of every intermediate NodeSet, so they are materialised while one is
+// set.
+func (c *exprContext) streaming() bool {
+	return c.Tracer == nil
+}
+
+// streams reports whether expr can be evaluated against the context as a
+// stream of nodes.
+func streams(context *exprContext, expr exprNode) bool {
+	if !context.streaming() {
+		return false
+	}
+
+	switch e := expr.(type) {
+	case pipeExpr:
+		_, ok := context.result.(NodeSet)
+		return ok
+	case streamer:
+		return e.streams()
+	}
+
+	return false
+}
+
+// streamContext evaluates expr against the context as a stream of nodes.  If
+// expr cannot be streamed, it is evaluated in full and its NodeSet streamed.
+func streamContext(context *exprContext, expr exprNode) (nodeStream, error) {
+	if streams(context, expr) {
+		return openStream(context, func() (nodeStream, error) {
+			if pipe, ok := expr.(pipeExpr); ok {
+				return pipe.pipe(context, nodeSetStream(context.result.(NodeSet)))
+			}
+
+			return expr.(streamer).stream(context)
+		})
+	}
+
+	result, err := execIndependent(context, expr)
+
+	if err != nil {
+		return nodeStream{}, err
+	}
+
+	return resultStream(result)
+}
+
+// pipeContext pipes the input through expr.
+func pipeContext(context *exprContext, expr pipeExpr, input nodeStream) (nodeStream, error) {
+	return openStream(context, func() (nodeStream, error) {
+		return expr.pipe(context, input)
+	})
+}
+
+// openStream is execNode for streams.  Opening a stream counts towards the
+// MaxDepth budget, and its nodes count towards MaxNodesVisited as they are
+// pulled.
+func openStream(context *exprContext, open func() (nodeStream, error)) (nodeStream, error) {
+	state := context.state
+
+	if err := state.ctx.Err(); err != nil {
+		return nodeStream{}, err
+	}
+
+	state.depth++
+
+	if context.MaxDepth > 0 && state.depth > context.MaxDepth {
+		return nodeStream{}, &LimitError{Limit: LimitDepth, Max: context.MaxDepth}
+	}
+
+	ret, err := open()
+	state.depth--
+	return ret, err
+}
+
+// execStream evaluates a streamer by gathering its whole stream.
+func execStream(context *exprContext, expr streamer) error {
+	stream, err := expr.stream(context)
+
+	if err != nil {
+		return err
+	}
+
+	nodeSet, err := collect(context, stream)
+
+	if err != nil {
+		return err
+	}
+
+	context.result = nodeSet
+	return nil
+}
+
+// execBool evaluates expr as a boolean.  NodeSets are only read up to their
+// first node.
+func execBool(context *exprContext, expr exprNode) (bool, error) {
+	if streams(context, expr) {
+		stream, err := streamContext(context, expr)
+
+		if err != nil {
+			return false, err
+		}
+
+		first, err := stream.next()
+		return first != nil, err
+	}
+
+	result, err := execIndependent(context, expr)
+
+	if err != nil {
+		return false, err
+	}
+
+	return effectiveBool(result)
+}
+
+// collect gathers the rest of the nodes in a stream.  It fails as soon as
+// there are more than the context's MaxNodeSetSize.
+func collect(context *exprContext, stream nodeIterator) (NodeSet, error) {
+	ret := make(NodeSet, 0)
+
+	for {
+		next, err := stream.next()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if next == nil {
+			return ret, nil
+		}
+
+		ret = append(ret, next)
+
+		if err := context.checkNodeSetSize(ret); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// resultStream streams the nodes of a NodeSet result.
+func resultStream(result Result) (nodeStream, error) {
+	nodeSet, ok := result.(NodeSet)
+
+	if !ok {
+		return nodeStream{}, ErrNotNodeSet
+	}
+
+	return nodeSetStream(nodeSet), nil
+}
+
+// nodeSetStream streams the nodes of a NodeSet.  Nothing is known about their
+// order unless there is at most one.
+func nodeSetStream(nodeSet NodeSet) nodeStream {
+	single := len(nodeSet) <= 1
+	return nodeStream{nodeIterator: &sliceIterator{nodes: nodeSet}, sorted: single, flat: single}
+}
+
+type sliceIterator struct {
+	nodes []store.Cursor
+}
+
+func (s *sliceIterator) next() (store.Cursor, error) {
+	if len(s.nodes) == 0 {
+		return nil, nil
+	}
+
+	ret := s.nodes[0]
+	s.nodes = s.nodes[1:]
+	return ret, nil
+}
+
+// expandIterator replaces each input node with the nodes expand returns for
+// it.
+type expandIterator struct {
+	input   nodeIterator
+	expand  func(store.Cursor) nodeIterator
+	current nodeIterator
+}
+
+func (e *expandIterator) next() (store.Cursor, error) {
+	for {
+		if e.current != nil {
+			next, err := e.current.next()
+
+			if next != nil || err != nil {
+				return next, err
+			}
+		}
+
+		input, err := e.input.next()
+
+		if input == nil || err != nil {
+			return nil, err
+		}
+
+		e.current = e.expand(input)
+	}
+}
+
+// descendantIterator walks the descendants of a node in document order.
+type descendantIterator struct {
+	stack [][]store.Cursor
+}
+
+func newDescendantIterator(cursor store.Cursor, self bool) *descendantIterator {
+	if self {
+		return &descendantIterator{stack: [][]store.Cursor{{cursor}}}
+	}
+
+	return &descendantIterator{stack: [][]store.Cursor{cursor.Children()}}
+}
+
+func (d *descendantIterator) next() (store.Cursor, error) {
+	for len(d.stack) > 0 {
+		top := len(d.stack) - 1
+
+		if len(d.stack[top]) == 0 {
+			d.stack = d.stack[:top]
+			continue
+		}
+
+		ret := d.stack[top][0]
+		d.stack[top] = d.stack[top][1:]
+
+		if children := ret.Children(); len(children) > 0 {
+			d.stack = append(d.stack, children)
+		}
+
+		return ret, nil
+	}
+
+	return nil, nil
+}
+
+// visitIterator counts the nodes pulled along an axis towards the
+// MaxNodesVisited budget, and stops once the query is cancelled.
+type visitIterator struct {
+	input   nodeIterator
+	context *exprContext
+}
+
+func (v *visitIterator) next() (store.Cursor, error) {
+	if err := v.context.state.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	next, err := v.input.next()
+
+	if next == nil || err != nil {
+		return nil, err
+	}
+
+	return next, v.context.visitNodes(1)
+}
+
+// testIterator skips the nodes that do not match a node test.
+type testIterator struct {
+	input nodeIterator
+	test  *nodeTest
+}
+
+func (t *testIterator) next() (store.Cursor, error) {
+	for {
+		next, err := t.input.next()
+
+		if next == nil || err != nil {
+			return nil, err
+		}
+
+		if t.test.matches(next) {
+			return next, nil
+		}
+	}
+}
+
+// streamAxis streams the nodes along the axis from each input node.  Only the
+// axes whose nodes can be produced in document order are streamed, and only
+// from flat input; the rest are selected in full.
+func (e *stepExpr) streamAxis(context *exprContext, input nodeStream) (nodeStream, error) {
+	if e.axis == axisSelf {
+		return input, nil
+	}
+
+	var expand func(store.Cursor) nodeIterator
+	flat := false
+
+	if input.sorted && input.flat {
+		switch e.axis {
+		case axisChild:
+			flat = true
+			expand = func(c store.Cursor) nodeIterator {
+				return &sliceIterator{nodes: c.Children()}
+			}
+		case axisAttribute:
+			flat = true
+			expand = func(c store.Cursor) nodeIterator {
+				return &sliceIterator{nodes: c.Attributes()}
+			}
+		case axisDescendant:
+			expand = func(c store.Cursor) nodeIterator {
+				if index, ok := indexable(c); ok && e.test.isName() {
+					return &sliceIterator{nodes: index.DescendantsNamed(e.test.space, e.test.local)}
+				}
+
+				return newDescendantIterator(c, false)
+			}
+		case axisDescendantOrSelf:
+			expand = func(c store.Cursor) nodeIterator {
+				return newDescendantIterator(c, true)
+			}
+		}
+	}
+
+	if expand != nil {
+		return nodeStream{
+			nodeIterator: &visitIterator{
+				input:   &expandIterator{input: input, expand: expand},
+				context: context,
+			},
+			sorted: true,
+			flat:   flat,
+		}, nil
+	}
+
+	nodeSet, err := collect(context, input)
+
+	if err != nil {
+		return nodeStream{}, err
+	}
+
+	selected := e.selectNodes(nodeSet)
+
+	if err := context.visitNodes(len(selected)); err != nil {
+		return nodeStream{}, err
+	}
+
+	if err := context.checkNodeSetSize(selected); err != nil {
+		return nodeStream{}, err
+	}
+
+	ret := nodeSetStream(selected)
+
+	switch e.axis {
+	case axisChild, axisDescendant, axisDescendantOrSelf, axisFollowing, axisFollowingSibling:
+		ret.sorted = true
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,283 @@
+package exec
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// FunctionLibrary bundles a set of functions under one namespace, so they can
+// be bound to a query together.
+type FunctionLibrary struct {
+	// Namespace is the namespace URI of every function in the library.
+	Namespace string
+	// Prefix is bound to the Namespace, unless the query already binds it.
+	Prefix    string
+	Functions []LibraryFunction
+}
+
+// LibraryFunction is one of the functions in a FunctionLibrary.  Exactly one
+// of Function and Lazy must be set.  A Function is bound with the Signature,
+// so its arguments are checked and coerced; a LazyFunction only has the
+// number of its arguments checked.
+type LibraryFunction struct {
+	Local     string
+	Signature Signature
+	Function  Function
+	Lazy      LazyFunction
+}
+
+// Apply binds the library's functions, and its prefix, to the settings.  If a
+// function sets neither or both of Function and Lazy, queries with the
+// settings fail.
+func (l *FunctionLibrary) Apply(c *ContextSettings) {
+	if _, ok := c.NamespaceDecls[l.Prefix]; !ok && l.Prefix != "" {
+		c.NamespaceDecls[l.Prefix] = l.Namespace
+	}
+
+	for _, i := range l.Functions {
+		name := XmlName{Space: l.Namespace, Local: i.Local}
+
+		if (i.Function == nil) == (i.Lazy == nil) {
+			c.Fail(fmt.Errorf("library function %s must set exactly one of Function and Lazy", name))
+			continue
+		}
+
+		c.FunctionSignatures[name] = i.Signature
+
+		if i.Lazy != nil {
+			c.LazyFunctions[name] = i.Signature.bindLazy(i.Lazy)
+		} else {
+			c.FunctionLibrary[name] = i.Signature.Bind(i.Function)
+		}
+	}
+}
+
+// FunctionInfo describes a function available to a query.
+type FunctionInfo struct {
+	Name XmlName
+	// Signature is only set if HasSignature is.
+	Signature    Signature
+	HasSignature bool
+	Builtin      bool
+	Lazy         bool
+}
+
+func (f FunctionInfo) String() string {
+	if !f.HasSignature {
+		return f.Name.String() + "(...)"
+	}
+
+	return f.Name.String() + f.Signature.String()
+}
+
+// ListFunctions lists the functions available to a query with the given
+// settings, sorted by namespace and then name.  Built-in functions replaced
+// by custom ones are only listed once.  Errors recorded by the settings are
+// ignored.
+func ListFunctions(settings ...ContextApply) []FunctionInfo {
+	contextSettings, _ := buildContextSettings(settings...)
+	names := make(map[XmlName]bool)
+
+	for i := range builtinFunctions {
+		names[i] = true
+	}
+
+	for i := range contextSettings.FunctionLibrary {
+		names[i] = true
+	}
+
+	for i := range contextSettings.LazyFunctions {
+		names[i] = true
+	}
+
+	ret := make([]FunctionInfo, 0, len(names))
+
+	for name := range names {
+		sig, ok := contextSettings.signature(name)
+
+		ret = append(ret, FunctionInfo{
+			Name:         name,
+			Signature:    sig,
+			HasSignature: ok,
+			Builtin:      !contextSettings.isCustom(name),
+			Lazy:         contextSettings.LazyFunctions[name] != nil,
+		})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Name.Space != ret[j].Name.Space {
+			return ret[i].Name.Space < ret[j].Name.Space
+		}
+
+		return ret[i].Name.Local < ret[j].Name.Local
+	})
+
+	return ret
+}
+
+const (
+	// StringsNamespace is the namespace of StringsLibrary.
+	StringsNamespace = "https://github.com/ChrisTrenkamp/xsel/strings"
+	// MathNamespace is the namespace of MathLibrary.
+	MathNamespace = "https://github.com/ChrisTrenkamp/xsel/math"
+)
+
+// StringsLibrary returns string functions that XPath 1.0 lacks, under the
+// "str" prefix: upper-case, lower-case, ends-with, trim, join, matches and
+// replace.  Patterns are Go regular expressions.
+func StringsLibrary() *FunctionLibrary {
+	str := Param{Type: TypeString}
+
+	return &FunctionLibrary{
+		Namespace: StringsNamespace,
+		Prefix:    "str",
+		Functions: []LibraryFunction{
+			{"upper-case", Signature{[]Param{str}, TypeString}, strUpperCase, nil},
+			{"lower-case", Signature{[]Param{str}, TypeString}, strLowerCase, nil},
+			{"ends-with", Signature{[]Param{str, str}, TypeBool}, strEndsWith, nil},
+			{"trim", Signature{[]Param{str}, TypeString}, strTrim, nil},
+			{"join", Signature{[]Param{{Type: TypeSequence}, {Name: "separator", Type: TypeString, Optional: true}}, TypeString}, strJoin, nil},
+			{"matches", Signature{[]Param{str, {Name: "pattern", Type: TypeString}}, TypeBool}, strMatches, nil},
+			{"replace", Signature{[]Param{str, {Name: "pattern", Type: TypeString}, {Name: "replacement", Type: TypeString}}, TypeString}, strReplace, nil},
+		},
+	}
+}
+
+func strUpperCase(context Context, args ...Result) (Result, error) {
+	return String(strings.ToUpper(args[0].String())), nil
+}
+
+func strLowerCase(context Context, args ...Result) (Result, error) {
+	return String(strings.ToLower(args[0].String())), nil
+}
+
+func strEndsWith(context Context, args ...Result) (Result, error) {
+	return Bool(strings.HasSuffix(args[0].String(), args[1].String())), nil
+}
+
+func strTrim(context Context, args ...Result) (Result, error) {
+	return String(strings.TrimSpace(args[0].String())), nil
+}
+
+func strJoin(context Context, args ...Result) (Result, error) {
+	separator := ""
+
+	if len(args) > 1 {
+		separator = args[1].String()
+	}
+
+	values := make([]string, 0)
+
+	for _, i := range Items(args[0]) {
+		values = append(values, i.String())
+	}
+
+	return String(strings.Join(values, separator)), nil
+}
+
+func strMatches(context Context, args ...Result) (Result, error) {
+	pattern, err := regexp.Compile(args[1].String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Bool(pattern.MatchString(args[0].String())), nil
+}
+
+func strReplace(context Context, args ...Result) (Result, error) {
+	pattern, err := regexp.Compile(args[1].String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return String(pattern.ReplaceAllString(args[0].String(), args[2].String())), nil
+}
+
+// MathLibrary returns numeric functions that XPath 1.0 lacks, under the
+// "math" prefix: abs, min, max, avg, pow and sqrt.  min, max and avg take a
+// node-set or Sequence, and return NaN if it is empty.
+func MathLibrary() *FunctionLibrary {
+	num := Param{Type: TypeNumber}
+	items := Param{Type: TypeSequence}
+
+	return &FunctionLibrary{
+		Namespace: MathNamespace,
+		Prefix:    "math",
+		Functions: []LibraryFunction{
+			{"abs", Signature{[]Param{num}, TypeNumber}, mathAbs, nil},
+			{"min", Signature{[]Param{items}, TypeNumber}, mathMin, nil},
+			{"max", Signature{[]Param{items}, TypeNumber}, mathMax, nil},
+			{"avg", Signature{[]Param{items}, TypeNumber}, mathAvg, nil},
+			{"pow", Signature{[]Param{num, num}, TypeNumber}, mathPow, nil},
+			{"sqrt", Signature{[]Param{num}, TypeNumber}, mathSqrt, nil},
+		},
+	}
+}
+
+func mathAbs(context Context, args ...Result) (Result, error) {
+	return Number(math.Abs(args[0].Number())), nil
+}
+
+// numbers returns the number values of the items of a node-set or Sequence.
+func numbers(items Result) []float64 {
+	ret := make([]float64, 0)
+
+	for _, i := range Items(items) {
+		ret = append(ret, i.Number())
+	}
+
+	return ret
+}
+
+func mathMin(context Context, args ...Result) (Result, error) {
+	ret := math.NaN()
+
+	for i, n := range numbers(args[0]) {
+		if i == 0 || n < ret {
+			ret = n
+		}
+	}
+
+	return Number(ret), nil
+}
+
+func mathMax(context Context, args ...Result) (Result, error) {
+	ret := math.NaN()
+
+	for i, n := range numbers(args[0]) {
+		if i == 0 || n > ret {
+			ret = n
+		}
+	}
+
+	return Number(ret), nil
+}
+
+func mathAvg(context Context, args ...Result) (Result, error) {
+	values := numbers(args[0])
+
+	if len(values) == 0 {
+		return Number(math.NaN()), nil
+	}
+
+	sum := 0.0
+
+	for _, n := range values {
+		sum += n
+	}
+
+	return Number(sum / float64(len(values))), nil
+}
+
+func mathPow(context Context, args ...Result) (Result, error) {
+	return Number(math.Pow(args[0].Number(), args[1].Number())), nil
+}
+
+func mathSqrt(context Context, args ...Result) (Result, error) {
+	return Number(math.Sqrt(args[0].Number())), nil
+}
@@ -0,0 +1,66 @@
+package exec
+
+import "fmt"
+
+// Limit identifies one of the evaluation budgets in ContextSettings.
+type Limit int
+
+const (
+	// LimitNodesVisited is the ContextSettings.MaxNodesVisited budget.
+	LimitNodesVisited Limit = iota
+	// LimitDepth is the ContextSettings.MaxDepth budget.
+	LimitDepth
+	// LimitNodeSetSize is the ContextSettings.MaxNodeSetSize budget.
+	LimitNodeSetSize
+	// LimitRecursion is the ContextSettings.MaxRecursion budget.
+	LimitRecursion
+)
+
+func (l Limit) String() string {
+	switch l {
+	case LimitNodesVisited:
+		return "maximum nodes visited"
+	case LimitDepth:
+		return "maximum recursion depth"
+	case LimitNodeSetSize:
+		return "maximum NodeSet size"
+	case LimitRecursion:
+		return "maximum function recursion"
+	}
+
+	return fmt.Sprintf("Limit(%d)", int(l))
+}
+
+// LimitError is returned when a query exceeds one of the evaluation budgets
+// in ContextSettings.
+type LimitError struct {
+	Limit Limit
+	Max   int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("xpath query exceeded the %s limit of %d", e.Limit, e.Max)
+}
+
+func (c *exprContext) visitNodes(count int) error {
+	c.state.nodesVisited += count
+
+	if c.MaxNodesVisited > 0 && c.state.nodesVisited > c.MaxNodesVisited {
+		return &LimitError{Limit: LimitNodesVisited, Max: c.MaxNodesVisited}
+	}
+
+	return nil
+}
+
+func (c *exprContext) checkNodeSetSize(nodeSet NodeSet) error {
+	return c.checkSize(len(nodeSet))
+}
+
+// checkSize checks the size of a NodeSet or Sequence.
+func (c *exprContext) checkSize(size int) error {
+	if c.MaxNodeSetSize > 0 && size > c.MaxNodeSetSize {
+		return &LimitError{Limit: LimitNodeSetSize, Max: c.MaxNodeSetSize}
+	}
+
+	return nil
+}
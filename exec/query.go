@@ -0,0 +1,115 @@
+package exec
+
+import (
+	"context"
+
+	"github.com/ChrisTrenkamp/xsel/grammar"
+	"github.com/ChrisTrenkamp/xsel/store"
+)
+
+// Query is a compiled XPath query, bundled with the settings it runs with.
+// The settings are applied once, when the Query is prepared.  A Query is
+// immutable, and can be run any number of times, from any number of
+// goroutines.
+type Query struct {
+	expr     *Expr
+	settings ContextSettings
+}
+
+// Prepare compiles a XPath query with the given settings.
+func Prepare(expr *grammar.Grammar, settings ...ContextApply) (*Query, error) {
+	contextSettings, err := buildContextSettings(settings...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := compileRecover(newCompiler(&contextSettings), expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Query{expr: compiled, settings: contextSettings}, nil
+}
+
+// Expr returns the compiled query.
+func (q *Query) Expr() *Expr {
+	return q.expr
+}
+
+// Run executes the query against the given Cursor.  The overrides are
+// applied to a copy of the query's settings, so they only affect this run.
+// Namespace bindings were resolved when the query was prepared, so
+// overriding them has no effect on the query itself.
+func (q *Query) Run(cursor store.Cursor, overrides ...ContextApply) (Result, error) {
+	return q.RunContext(context.Background(), cursor, overrides...)
+}
+
+// Like Run, except the query stops with the context's error as soon as ctx
+// is cancelled or its deadline passes.
+func (q *Query) RunContext(ctx context.Context, cursor store.Cursor, overrides ...ContextApply) (Result, error) {
+	settings, err := q.override(overrides)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return execExpr(ctx, cursor, q.expr, settings)
+}
+
+// Iterate is like the package's Iterate, for the query.
+func (q *Query) Iterate(cursor store.Cursor, fn func(store.Cursor) bool, overrides ...ContextApply) error {
+	return q.IterateContext(context.Background(), cursor, fn, overrides...)
+}
+
+// Like Iterate, except the query stops with the context's error as soon as
+// ctx is cancelled or its deadline passes.
+func (q *Query) IterateContext(ctx context.Context, cursor store.Cursor, fn func(store.Cursor) bool, overrides ...ContextApply) error {
+	settings, err := q.override(overrides)
+
+	if err != nil {
+		return err
+	}
+
+	return iterate(newExprContext(ctx, cursor, settings), q.expr.root, fn)
+}
+
+// override returns the query's settings with the overrides applied.  The
+// query's own settings are shared, read-only, by every run without overrides.
+// It returns the first error the overrides recorded with Fail.
+func (q *Query) override(overrides []ContextApply) (ContextSettings, error) {
+	if len(overrides) == 0 {
+		return q.settings, nil
+	}
+
+	ret := q.settings.clone()
+
+	for _, i := range overrides {
+		i(&ret)
+	}
+
+	return ret, ret.err
+}
+
+// clone copies the settings, along with their maps and slices.
+func (c *ContextSettings) clone() ContextSettings {
+	ret := *c
+	ret.NamespaceDecls = cloneMap(c.NamespaceDecls)
+	ret.FunctionLibrary = cloneMap(c.FunctionLibrary)
+	ret.FunctionSignatures = cloneMap(c.FunctionSignatures)
+	ret.LazyFunctions = cloneMap(c.LazyFunctions)
+	ret.Variables = cloneMap(c.Variables)
+	ret.IDAttributes = append([]XmlName(nil), c.IDAttributes...)
+	return ret
+}
+
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	ret := make(map[K]V, len(m))
+
+	for k, v := range m {
+		ret[k] = v
+	}
+
+	return ret
+}
@@ -0,0 +1,117 @@
+package exec
+
+import "fmt"
+
+// VariableResolver looks up a variable that is not bound in
+// ContextSettings.Variables.  ok is false if there is no such variable.
+type VariableResolver func(name XmlName) (value Result, ok bool, err error)
+
+// FunctionResolver looks up a function that is neither a custom nor a
+// built-in function, for a call with the given number of arguments.  ok is
+// false if there is no such function.
+type FunctionResolver func(name XmlName, arity int) (fn Function, ok bool)
+
+type resolvedFunction struct {
+	name  XmlName
+	arity int
+}
+
+// variable looks up a variable, and then asks the VariableResolver if it is
+// not bound.  Variables bound in the query, by let, for, some and every
+// expressions, shadow the ones in Variables.  Resolved variables are kept for the rest of the query.
+func (c *exprContext) variable(name XmlName) (Result, bool, error) {
+	if value, ok := c.scope.lookup(name); ok {
+		return value, true, nil
+	}
+
+	if value := c.Variables[name]; value != nil {
+		return value, true, nil
+	}
+
+	if c.VariableResolver == nil {
+		return nil, false, nil
+	}
+
+	state := c.state
+
+	if value, ok := state.variables[name]; ok {
+		return value, true, nil
+	}
+
+	value, ok, err := c.VariableResolver(name)
+
+	if err != nil {
+		return nil, false, fmt.Errorf("could not resolve variable %s: %w", name, err)
+	}
+
+	if !ok || value == nil {
+		return nil, false, nil
+	}
+
+	if state.variables == nil {
+		state.variables = make(map[XmlName]Result)
+	}
+
+	state.variables[name] = value
+	return value, true, nil
+}
+
+// resolveFunction asks the FunctionResolver for a function.  Resolved
+// functions are kept for the rest of the query.
+func (c *exprContext) resolveFunction(name XmlName, arity int) Function {
+	if c.FunctionResolver == nil {
+		return nil
+	}
+
+	state := c.state
+	key := resolvedFunction{name, arity}
+
+	if fn, ok := state.functions[key]; ok {
+		return fn
+	}
+
+	fn, ok := c.FunctionResolver(name, arity)
+
+	if !ok {
+		fn = nil
+	}
+
+	if state.functions == nil {
+		state.functions = make(map[resolvedFunction]Function)
+	}
+
+	state.functions[key] = fn
+	return fn
+}
+
+// resolvesFunction reports whether the FunctionResolver knows a function.
+func (c *ContextSettings) resolvesFunction(name XmlName, arity int) bool {
+	if c.FunctionResolver == nil {
+		return false
+	}
+
+	_, ok := c.FunctionResolver(name, arity)
+	return ok
+}
+
+// resolvesVariable returns an error unless the variable is bound, or the
+// VariableResolver knows it.
+func (c *ContextSettings) resolvesVariable(name XmlName) error {
+	if c.Variables[name] != nil {
+		return nil
+	}
+
+	if c.VariableResolver != nil {
+		value, ok, err := c.VariableResolver(name)
+
+		if err != nil {
+			return fmt.Errorf("could not resolve variable %s: %w", name, err)
+		}
+
+		if ok && value != nil {
+			return nil
+		}
+	}
+
+	return errorf(ErrUnboundVariable, "could not find variable %s", name)
+}
@@ -97,6 +97,98 @@ func (n NodeSet) Bool() bool {
 	return len(n) > 0
 }
 
+// Sequence is an ordered list of items, which may mix nodes with strings,
+// numbers and booleans.  Queries only return a Sequence if its items are not
+// all nodes; a sequence of nodes is returned as a NodeSet, in document order
+// and without duplicates.
+//
+// Like a NodeSet, the string and number value of a Sequence is that of its
+// first item.  A Sequence is true if its first item is a node, or its only
+// item is true.  A Sequence of more than one string, number or boolean has no
+// boolean value: Bool returns false for it, and queries that use it as a
+// boolean fail with ErrNotBoolean.
+type Sequence []Result
+
+func (s Sequence) String() string {
+	items := Items(s)
+
+	if len(items) == 0 {
+		return ""
+	}
+
+	return items[0].String()
+}
+
+func (s Sequence) Number() float64 {
+	items := Items(s)
+
+	if len(items) == 0 {
+		return math.NaN()
+	}
+
+	return items[0].Number()
+}
+
+func (s Sequence) Bool() bool {
+	items := Items(s)
+
+	if len(items) == 0 {
+		return false
+	}
+
+	if _, ok := items[0].(NodeSet); ok {
+		return true
+	}
+
+	return len(items) == 1 && items[0].Bool()
+}
+
+// effectiveBool returns the boolean value of a Result, or ErrNotBoolean if it
+// is a Sequence without one.
+func effectiveBool(result Result) (bool, error) {
+	sequence, ok := result.(Sequence)
+
+	if !ok {
+		return result.Bool(), nil
+	}
+
+	items := Items(sequence)
+
+	if len(items) > 1 {
+		if _, ok := items[0].(NodeSet); !ok {
+			return false, errorf(ErrNotBoolean, "a sequence of %d items has no boolean value", len(items))
+		}
+	}
+
+	return sequence.Bool(), nil
+}
+
+// Items returns the items of a Result, in order.  Each node of a NodeSet is
+// an item, returned as a NodeSet of one node.  The items of a Sequence are
+// flattened into the result.  Any other Result is a single item.
+func Items(result Result) Sequence {
+	switch v := result.(type) {
+	case NodeSet:
+		ret := make(Sequence, 0, len(v))
+
+		for _, i := range v {
+			ret = append(ret, NodeSet{i})
+		}
+
+		return ret
+	case Sequence:
+		ret := make(Sequence, 0, len(v))
+
+		for _, i := range v {
+			ret = append(ret, Items(i)...)
+		}
+
+		return ret
+	}
+
+	return Sequence{result}
+}
+
 func getStringNumber(str string) float64 {
 	ret, err := strconv.ParseFloat(str, 64)
 
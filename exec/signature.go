@@ -0,0 +1,263 @@
+package exec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Type is the XPath type of a Function's parameter or result.
+type Type int
+
+const (
+	// TypeAny accepts any Result, without coercing it.
+	TypeAny Type = iota
+	TypeString
+	TypeNumber
+	TypeBool
+	TypeNodeSet
+	// TypeSequence is the type of a Sequence.  Every Result is a sequence of
+	// its Items, so parameters of this type accept any Result without
+	// coercing it.
+	TypeSequence
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeAny:
+		return "object"
+	case TypeString:
+		return "string"
+	case TypeNumber:
+		return "number"
+	case TypeBool:
+		return "boolean"
+	case TypeNodeSet:
+		return "node-set"
+	case TypeSequence:
+		return "sequence"
+	}
+
+	return fmt.Sprintf("Type(%d)", int(t))
+}
+
+// coerce converts a Result to the type, following the XPath rules.  Nothing
+// can be converted to a NodeSet.
+func (t Type) coerce(result Result) (Result, error) {
+	switch t {
+	case TypeString:
+		return String(result.String()), nil
+	case TypeNumber:
+		return Number(result.Number()), nil
+	case TypeBool:
+		b, err := effectiveBool(result)
+		return Bool(b), err
+	case TypeNodeSet:
+		if _, ok := result.(NodeSet); !ok {
+			return nil, errorf(ErrNotNodeSet, "expected a node-set, received a %s", typeOf(result))
+		}
+	}
+
+	return result, nil
+}
+
+func typeOf(result Result) Type {
+	switch result.(type) {
+	case String:
+		return TypeString
+	case Number:
+		return TypeNumber
+	case Bool:
+		return TypeBool
+	case NodeSet:
+		return TypeNodeSet
+	case Sequence:
+		return TypeSequence
+	}
+
+	return TypeAny
+}
+
+// Param is one of the parameters in a Signature.
+type Param struct {
+	// Name is only used to describe the parameter in errors and listings.
+	Name string
+	Type Type
+	// Optional parameters may be left out.  Only the trailing parameters of
+	// a Signature can be optional.
+	Optional bool
+	// Variadic parameters may be repeated.  Only the last parameter of a
+	// Signature can be variadic.  Unless it is also optional, it must be
+	// given at least once.
+	Variadic bool
+}
+
+func (p Param) String() string {
+	ret := p.Type.String()
+
+	if p.Name != "" {
+		ret = "$" + p.Name + " as " + ret
+	}
+
+	switch {
+	case p.Variadic && p.Optional:
+		ret += "*"
+	case p.Variadic:
+		ret += "+"
+	case p.Optional:
+		ret += "?"
+	}
+
+	return ret
+}
+
+// Signature declares the parameters and result of a Function.  Functions
+// bound with a Signature have their arguments checked and coerced to the
+// parameter types before they are called, and their result coerced to the
+// Returns type.  Check also uses it to verify the number of arguments in a
+// query.
+type Signature struct {
+	Params  []Param
+	Returns Type
+}
+
+func (s Signature) String() string {
+	params := make([]string, 0, len(s.Params))
+
+	for _, i := range s.Params {
+		params = append(params, i.String())
+	}
+
+	return fmt.Sprintf("(%s) %s", strings.Join(params, ", "), s.Returns)
+}
+
+func (s Signature) arity() arity {
+	ret := arity{max: len(s.Params)}
+
+	for i, p := range s.Params {
+		if !p.Optional {
+			ret.min = i + 1
+		}
+	}
+
+	if len(s.Params) > 0 && s.Params[len(s.Params)-1].Variadic {
+		ret.max = -1
+	}
+
+	return ret
+}
+
+// param returns the parameter the i'th argument is passed to.
+func (s Signature) param(i int) Param {
+	if i >= len(s.Params) {
+		return s.Params[len(s.Params)-1]
+	}
+
+	return s.Params[i]
+}
+
+// Bind returns a Function that checks and coerces its arguments, calls fn,
+// and then coerces its result, according to the Signature.
+func (s Signature) Bind(fn Function) Function {
+	arity := s.arity()
+
+	return func(context Context, args ...Result) (Result, error) {
+		if !arity.accepts(len(args)) {
+			return nil, fmt.Errorf("%w: expected %s, received %d", ErrArity, arity, len(args))
+		}
+
+		coerced := make([]Result, len(args))
+
+		for i, arg := range args {
+			param := s.param(i)
+			value, err := param.Type.coerce(arg)
+
+			if err != nil {
+				return nil, fmt.Errorf("argument %d (%s): %w", i+1, param, err)
+			}
+
+			coerced[i] = value
+		}
+
+		result, err := fn(context, coerced...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if result, err = s.Returns.coerce(result); err != nil {
+			return nil, fmt.Errorf("result: %w", err)
+		}
+
+		return result, nil
+	}
+}
+
+// bindLazy returns a LazyFunction that checks the number of its arguments
+// before calling fn.
+func (s Signature) bindLazy(fn LazyFunction) LazyFunction {
+	arity := s.arity()
+
+	return func(context Context, args ...Thunk) (Result, error) {
+		if !arity.accepts(len(args)) {
+			return nil, fmt.Errorf("%w: expected %s, received %d", ErrArity, arity, len(args))
+		}
+
+		return fn(context, args...)
+	}
+}
+
+// signature returns the Signature of the function a query would call, if it
+// is known.
+func (c *ContextSettings) signature(name XmlName) (Signature, bool) {
+	if c.isCustom(name) {
+		sig, ok := c.FunctionSignatures[name]
+		return sig, ok
+	}
+
+	sig, ok := builtinSignatures[name]
+	return sig, ok
+}
+
+func params(types ...Type) []Param {
+	ret := make([]Param, 0, len(types))
+
+	for _, i := range types {
+		ret = append(ret, Param{Type: i})
+	}
+
+	return ret
+}
+
+func optional(t Type) Param {
+	return Param{Type: t, Optional: true}
+}
+
+var builtinSignatures = map[XmlName]Signature{
+	{"", "last"}:             {Returns: TypeNumber},
+	{"", "position"}:         {Returns: TypeNumber},
+	{"", "count"}:            {params(TypeSequence), TypeNumber},
+	{"", "local-name"}:       {[]Param{optional(TypeNodeSet)}, TypeString},
+	{"", "namespace-uri"}:    {[]Param{optional(TypeNodeSet)}, TypeString},
+	{"", "name"}:             {[]Param{optional(TypeNodeSet)}, TypeString},
+	{"", "string"}:           {[]Param{optional(TypeAny)}, TypeString},
+	{"", "concat"}:           {append(params(TypeString), Param{Type: TypeString, Variadic: true}), TypeString},
+	{"", "starts-with"}:      {params(TypeString, TypeString), TypeBool},
+	{"", "contains"}:         {params(TypeString, TypeString), TypeBool},
+	{"", "substring-before"}: {params(TypeString, TypeString), TypeString},
+	{"", "substring-after"}:  {params(TypeString, TypeString), TypeString},
+	{"", "substring"}:        {append(params(TypeString, TypeNumber), optional(TypeNumber)), TypeString},
+	{"", "string-length"}:    {[]Param{optional(TypeString)}, TypeNumber},
+	{"", "normalize-space"}:  {[]Param{optional(TypeString)}, TypeString},
+	{"", "translate"}:        {params(TypeString, TypeString, TypeString), TypeString},
+	{"", "boolean"}:          {params(TypeAny), TypeBool},
+	{"", "not"}:              {params(TypeBool), TypeBool},
+	{"", "true"}:             {Returns: TypeBool},
+	{"", "false"}:            {Returns: TypeBool},
+	{"", "lang"}:             {params(TypeString), TypeBool},
+	{"", "number"}:           {[]Param{optional(TypeAny)}, TypeNumber},
+	{"", "sum"}:              {params(TypeSequence), TypeNumber},
+	{"", "floor"}:            {params(TypeNumber), TypeNumber},
+	{"", "ceiling"}:          {params(TypeNumber), TypeNumber},
+	{"", "round"}:            {params(TypeNumber), TypeNumber},
+	{"", "id"}:               {params(TypeAny), TypeNodeSet},
+}
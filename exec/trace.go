@@ -0,0 +1,190 @@
+package exec
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TraceEvent describes the evaluation of one part of a XPath query.
+type TraceEvent struct {
+	// Span is the part of the query being evaluated.
+	Span Span
+	// InputSize is the size of the NodeSet the expression was evaluated
+	// against, or -1 if it was evaluated against some other Result.
+	InputSize int
+	// OutputSize is the size of the NodeSet the expression returned, or -1
+	// if it returned some other Result or failed.  It is always -1 on Enter.
+	OutputSize int
+	// Elapsed is how long the expression took to evaluate, including the
+	// expressions nested within it.  It is always zero on Enter.
+	Elapsed time.Duration
+	// Err is the error the expression failed with, if any.
+	Err error
+	// node is the expression being evaluated.  Expressions that wrap
+	// another, like hoisted subexpressions, share its Span.
+	node exprNode
+}
+
+// Tracer is notified as each part of a XPath query is evaluated.  Enter is
+// called before an expression is evaluated, and Leave afterward.  Calls to
+// Enter and Leave are nested like the expressions themselves.
+type Tracer interface {
+	Enter(event TraceEvent)
+	Leave(event TraceEvent)
+}
+
+func resultSize(result Result) int {
+	if nodeSet, ok := result.(NodeSet); ok {
+		return len(nodeSet)
+	}
+
+	return -1
+}
+
+// ProfileStep is the statistics a Profiler gathered for one part of a XPath
+// query.
+type ProfileStep struct {
+	Span Span
+	// Kind describes the expression, like the first line of its Explain
+	// output.  It tells apart the expressions that share a Span, such as a
+	// hoisted subexpression and the expression that caches it.
+	Kind string
+	// Calls is the number of times the expression was evaluated.
+	Calls int
+	// NodesIn and NodesOut are the total sizes of the NodeSet's the
+	// expression was evaluated against and returned.
+	NodesIn  int
+	NodesOut int
+	// Total is the time spent evaluating the expression, and Self is the
+	// portion of it that wasn't spent in the expressions nested within it.
+	Total time.Duration
+	Self  time.Duration
+}
+
+type profileFrame struct {
+	nested time.Duration
+}
+
+// profileKey identifies an expression across queries.  The Span alone would
+// merge the expressions that share it.
+type profileKey struct {
+	span Span
+	kind reflect.Type
+}
+
+// describe returns the first line of the expression's Explain output.
+func describe(expr exprNode) string {
+	if expr == nil {
+		return ""
+	}
+
+	x := &explainer{
+		seen: make(map[string]bool),
+	}
+
+	expr.explain(x)
+	line, _, _ := strings.Cut(x.buf.String(), "\n")
+	return line
+}
+
+// Profiler is a Tracer that aggregates the time spent and the number of nodes
+// processed by each part of a XPath query.  A Profiler can gather statistics
+// across any number of queries, but it must not be used by more than one
+// query at a time.
+type Profiler struct {
+	steps map[profileKey]*ProfileStep
+	stack []profileFrame
+}
+
+// NewProfiler creates an empty Profiler.
+func NewProfiler() *Profiler {
+	return &Profiler{
+		steps: make(map[profileKey]*ProfileStep),
+	}
+}
+
+func (p *Profiler) Enter(event TraceEvent) {
+	p.stack = append(p.stack, profileFrame{})
+}
+
+func (p *Profiler) Leave(event TraceEvent) {
+	frame := p.stack[len(p.stack)-1]
+	p.stack = p.stack[:len(p.stack)-1]
+
+	if len(p.stack) > 0 {
+		p.stack[len(p.stack)-1].nested += event.Elapsed
+	}
+
+	key := profileKey{event.Span, reflect.TypeOf(event.node)}
+	step := p.steps[key]
+
+	if step == nil {
+		step = &ProfileStep{Span: event.Span, Kind: describe(event.node)}
+		p.steps[key] = step
+	}
+
+	step.Calls++
+	step.Total += event.Elapsed
+	step.Self += event.Elapsed - frame.nested
+
+	if event.InputSize > 0 {
+		step.NodesIn += event.InputSize
+	}
+
+	if event.OutputSize > 0 {
+		step.NodesOut += event.OutputSize
+	}
+}
+
+// Report returns the statistics for each part of the profiled queries, in
+// the order they appear in the query.  Enclosing expressions come before the
+// expressions nested within them.
+func (p *Profiler) Report() []ProfileStep {
+	ret := make([]ProfileStep, 0, len(p.steps))
+
+	for _, i := range p.steps {
+		ret = append(ret, *i)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Span.Start != ret[j].Span.Start {
+			return ret[i].Span.Start < ret[j].Span.Start
+		}
+
+		if ret[i].Span.End != ret[j].Span.End {
+			return ret[i].Span.End > ret[j].Span.End
+		}
+
+		if ret[i].Span.Text != ret[j].Span.Text {
+			return ret[i].Span.Text < ret[j].Span.Text
+		}
+
+		return ret[i].Kind < ret[j].Kind
+	})
+
+	return ret
+}
+
+// WriteReport writes the Report as a table, one line per part of the query.
+func (p *Profiler) WriteReport(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%8s %10s %10s %12s %12s  %s\n", "calls", "nodes in", "nodes out", "total", "self", "expression (kind)")
+
+	if err != nil {
+		return err
+	}
+
+	for _, i := range p.Report() {
+		_, err := fmt.Fprintf(w, "%8d %10d %10d %12s %12s  %s (%s)\n",
+			i.Calls, i.NodesIn, i.NodesOut, i.Total, i.Self, strings.TrimSpace(i.Span.Text), i.Kind)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -5,11 +5,13 @@ import (
 	"reflect"
 
 	"github.com/ChrisTrenkamp/xsel/grammar"
+	"github.com/ChrisTrenkamp/xsel/store"
 )
 
 // Unmarshal maps a XPath result to a struct or slice.
-// When unmarshaling a slice, the result must be a NodeSet. When unmarshaling
-// a struct, the result must be a NodeSet with one result. To unmarshal a
+// When unmarshaling a slice, each of the result's Items is an element, so a
+// NodeSet gives one element per node, and a Sequence one per item. When
+// unmarshaling a struct, the result must be a NodeSet with one result. To unmarshal a
 // value to a struct field, give it a "xsel" tag name, and a XPath expression
 // for its value (e.g. `xsel:"//my-struct[@my-id = 'my-value']"`).
 //
@@ -19,6 +21,9 @@ import (
 // For slice elements, Unmarshal can set ints and uints, bools, strings, and
 // structs.  It cannot Unmarshal multidimensional slices.
 //
+// Absolute paths in tags select from the root of the document, unless the
+// settings give another DocumentRoot.
+//
 // Arrays, maps, and channels are not supported.
 func Unmarshal(result Result, value any, settings ...ContextApply) error {
 	return unmarshal(result, value, settings...)
@@ -54,6 +59,7 @@ func unmarshalStruct(result Result, val reflect.Value, settings ...ContextApply)
 	}
 
 	val = val.Elem()
+	settings = withDocumentRoot(cursor[0], settings)
 
 	numField := val.NumField()
 	valType := val.Type()
@@ -106,13 +112,22 @@ func unmarshalStruct(result Result, val reflect.Value, settings ...ContextApply)
 	return nil
 }
 
-func unmarshalSlice(result Result, val reflect.Value, settings ...ContextApply) error {
-	nodeset, ok := result.(NodeSet)
+// withDocumentRoot makes absolute paths select from the cursor's document,
+// unless the settings say otherwise.
+func withDocumentRoot(cursor store.Cursor, settings []ContextApply) []ContextApply {
+	ret := make([]ContextApply, len(settings), len(settings)+1)
+	copy(ret, settings)
 
-	if !ok {
-		return fmt.Errorf("slice unmarshals must operate on a NodeSet")
-	}
+	return append(ret, func(c *ContextSettings) {
+		if c.DocumentRoot != nil {
+			return
+		}
 
+		c.DocumentRoot = documentRoot(cursor)
+	})
+}
+
+func unmarshalSlice(result Result, val reflect.Value, settings ...ContextApply) error {
 	sliceType := reflect.TypeOf(val.Interface())
 	sliceElement := sliceType.Elem()
 	sliceElementKind := sliceElement.Kind()
@@ -122,7 +137,7 @@ func unmarshalSlice(result Result, val reflect.Value, settings ...ContextApply)
 		sliceElementKind = sliceElement.Kind()
 	}
 
-	for _, i := range nodeset {
+	for _, i := range Items(result) {
 		var sliceValue reflect.Value
 
 		if sliceElementKind == reflect.Slice {
@@ -131,14 +146,14 @@ func unmarshalSlice(result Result, val reflect.Value, settings ...ContextApply)
 			ptr := reflect.New(sliceElement)
 			ptr.Elem().Set(reflect.Zero(sliceElement))
 
-			err := unmarshal(NodeSet{i}, ptr.Interface(), settings...)
+			err := unmarshal(i, ptr.Interface(), settings...)
 			if err != nil {
 				return err
 			}
 
 			sliceValue = ptr.Elem()
 		} else {
-			val, ok := createValue(sliceElementKind, NodeSet{i})
+			val, ok := createValue(sliceElementKind, i)
 
 			if !ok {
 				return fmt.Errorf("invalid slice element type")
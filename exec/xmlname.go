@@ -1,7 +1,6 @@
 package exec
 
 import (
-	"fmt"
 	"strings"
 )
 
@@ -28,7 +27,7 @@ func GetQName(input string, namespaces map[string]string) (XmlName, error) {
 		ns, ok := namespaces[strings.TrimSpace(spl[0])]
 
 		if !ok {
-			return XmlName{}, fmt.Errorf("unknown namespace binding '%s'", spl[0])
+			return XmlName{}, errorf(ErrUnknownPrefix, "unknown namespace binding '%s'", spl[0])
 		}
 
 		ret.Space = ns
@@ -0,0 +1,100 @@
+package exec
+
+import (
+	"fmt"
+
+	"github.com/ChrisTrenkamp/xsel/grammar"
+)
+
+// DefaultMaxRecursion is the default ContextSettings.MaxRecursion.
+const DefaultMaxRecursion = 100
+
+// XPathFunction is a function whose body is a XPath query.  Its arguments are
+// bound to the variables named by its parameters, and its body is evaluated
+// against the caller's context node.  Like an Expr, it is immutable.
+type XPathFunction struct {
+	params []XmlName
+	body   *Expr
+}
+
+// CompileFunction compiles a function whose body is a XPath query, with the
+// given parameter names.  Namespace prefixes in the body are resolved from the
+// given settings, as Compile does.
+func CompileFunction(params []string, body *grammar.Grammar, settings ...ContextApply) (*XPathFunction, error) {
+	compiled, err := Compile(body, settings...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &XPathFunction{
+		params: make([]XmlName, len(params)),
+		body:   compiled,
+	}
+
+	for i, param := range params {
+		ret.params[i] = XmlName{Local: param}
+	}
+
+	return ret, nil
+}
+
+// Signature returns the function's Signature.  It accepts any argument, as
+// many as the function has parameters.
+func (f *XPathFunction) Signature() Signature {
+	params := make([]Param, len(f.params))
+
+	for i, param := range f.params {
+		params[i] = Param{Name: param.Local, Type: TypeAny}
+	}
+
+	return Signature{Params: params}
+}
+
+// Function returns the function, bound with its Signature.
+func (f *XPathFunction) Function() Function {
+	return f.Signature().Bind(f.call)
+}
+
+func (f *XPathFunction) call(context Context, args ...Result) (Result, error) {
+	caller, ok := context.(*exprContext)
+
+	if !ok {
+		return nil, fmt.Errorf("xpath functions can only be called by a query")
+	}
+
+	state := caller.state
+
+	if caller.MaxRecursion > 0 && state.recursion >= caller.MaxRecursion {
+		return nil, &LimitError{Limit: LimitRecursion, Max: caller.MaxRecursion}
+	}
+
+	body := caller.copy()
+	body.scope = nil
+	body.Variables = make(map[XmlName]Result, len(caller.Variables)+len(f.params))
+
+	for name, value := range caller.Variables {
+		body.Variables[name] = value
+	}
+
+	for i, param := range f.params {
+		body.Variables[param] = args[i]
+	}
+
+	// The body's hoisted subexpressions may read the parameters, so their
+	// results cannot be shared between calls.
+	hoisted := state.hoisted
+	state.hoisted = nil
+	state.recursion++
+
+	defer func() {
+		state.hoisted = hoisted
+		state.recursion--
+	}()
+
+	if err := execContext(&body, f.body.root); err != nil {
+		return nil, err
+	}
+
+	return body.result, nil
+}
@@ -0,0 +1,144 @@
+package grammar
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ChrisTrenkamp/xsel/grammar/parser"
+	"github.com/ChrisTrenkamp/xsel/grammar/token"
+)
+
+// SyntaxError is returned by Build when a XPath query cannot be parsed.
+type SyntaxError struct {
+	// Query is the XPath query that failed to parse.
+	Query string
+	// Line and Column are where the error occurred, starting from 1.
+	// Columns are counted in runes.
+	Line   int
+	Column int
+	// Offset is where the error occurred, in runes from the start of Query.
+	Offset int
+	// Found is the text the parser could not make sense of.  It is empty if
+	// the parser reached the end of the query.
+	Found string
+	// Expected is a sorted list of what the parser would have accepted
+	// instead.
+	Expected []string
+}
+
+func (e *SyntaxError) Error() string {
+	found := "end of query"
+
+	if e.Found != "" {
+		found = "'" + e.Found + "'"
+	}
+
+	return fmt.Sprintf("syntax error on line %d, column %d: unexpected %s, expected one of: %s",
+		e.Line, e.Column, found, strings.Join(e.Expected, ", "))
+}
+
+// Snippet returns the line of the query where the error occurred, with a
+// caret underneath the offending character.
+func (e *SyntaxError) Snippet() string {
+	query := []rune(e.Query)
+	start := e.Offset - (e.Column - 1)
+	end := start
+
+	for end < len(query) && query[end] != '\n' {
+		end++
+	}
+
+	caret := make([]rune, 0, e.Column)
+
+	for _, i := range query[start:e.Offset] {
+		if i == '\t' {
+			caret = append(caret, '\t')
+		} else {
+			caret = append(caret, ' ')
+		}
+	}
+
+	return string(query[start:end]) + "\n" + string(caret) + "^"
+}
+
+var friendlyTokenNames = map[string]string{
+	"$":                 "end of query",
+	"digits":            "number",
+	"doublequote":       "string literal",
+	"singlequote":       "string literal",
+	"ncname":            "name",
+	"variableReference": "variable reference",
+}
+
+func friendlyTokenName(id string) string {
+	if name, ok := friendlyTokenNames[id]; ok {
+		return name
+	}
+
+	return "'" + id + "'"
+}
+
+// locate sets the Line and Column of the Offset.
+func (e *SyntaxError) locate(query []rune) {
+	e.Line, e.Column = 1, 1
+
+	for _, i := range query[:e.Offset] {
+		if i == '\n' {
+			e.Line++
+			e.Column = 1
+		} else {
+			e.Column++
+		}
+	}
+}
+
+// newSyntaxError reports the errors that made it furthest into the query.
+// The parser reports an error for every alternative it tried, but the
+// furthest errors are the ones the query's author is interested in.
+func newSyntaxError(query []rune, errs []*parser.Error) *SyntaxError {
+	furthest := errs[0].Token
+
+	for _, e := range errs {
+		if e.Token.Lext() > furthest.Lext() {
+			furthest = e.Token
+		}
+	}
+
+	ret := &SyntaxError{
+		Query:  string(query),
+		Offset: furthest.Lext(),
+	}
+
+	if furthest.Type() != token.EOF {
+		ret.Found = furthest.LiteralString()
+	}
+
+	ret.locate(query)
+
+	// The lexer turns a comment that is never closed into an error token.
+	if furthest.Type() == token.Error && strings.HasPrefix(ret.Found, "(:") {
+		ret.Found = "(:"
+		ret.Expected = []string{"':)'"}
+		return ret
+	}
+
+	expected := make(map[string]bool)
+
+	for _, e := range errs {
+		if e.Token.Lext() != ret.Offset {
+			continue
+		}
+
+		for i := range e.Expected {
+			expected[friendlyTokenName(i.ID())] = true
+		}
+	}
+
+	for i := range expected {
+		ret.Expected = append(ret.Expected, i)
+	}
+
+	sort.Strings(ret.Expected)
+	return ret
+}
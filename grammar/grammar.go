@@ -1,8 +1,8 @@
 package grammar
 
 import (
-	"bytes"
 	"fmt"
+	"strings"
 
 	"github.com/ChrisTrenkamp/xsel/grammar/lexer"
 	"github.com/ChrisTrenkamp/xsel/grammar/parser"
@@ -25,49 +25,45 @@ func (g *Grammar) GetString() string {
 	return g.lex.GetString(g.BSR.LeftExtent(), g.BSR.RightExtent()-1)
 }
 
+// GetTokenString returns the text of the expression's tokens, without the
+// whitespace and comments between them.
+func (g *Grammar) GetTokenString() string {
+	ret := strings.Builder{}
+
+	for i := g.BSR.LeftExtent(); i < g.BSR.RightExtent(); i++ {
+		ret.WriteString(g.lex.Tokens[i].LiteralString())
+	}
+
+	return ret.String()
+}
+
 func (g *Grammar) GetStringExtents(left, right int) string {
 	return g.lex.GetString(left, right-1)
 }
 
-type errPos struct {
-	line, col int
+// Returns the offsets, in runes, of the given extents in the XPath query.
+func (g *Grammar) GetRuneExtents(left, right int) (int, int) {
+	if right <= left {
+		start := len(g.lex.I)
+
+		if left < len(g.lex.Tokens) {
+			start = g.lex.Tokens[left].Lext()
+		}
+
+		return start, start
+	}
+
+	return g.lex.Tokens[left].Lext(), g.lex.Tokens[right-1].Rext()
 }
 
-// Creates an XPath query.
+// Creates an XPath query.  The query may contain XPath 2.0 comments,
+// "(: ... :)", anywhere whitespace is allowed.
 func Build(xpath string) (Grammar, error) {
 	lex := lexer.New([]rune(xpath))
 	parse, err := parser.Parse(lex)
 
 	if len(err) > 0 {
-		errorPositions := make(map[errPos][]parser.Error)
-
-		for _, e := range err {
-			pos := errPos{line: e.Line, col: e.Column}
-			errorPositions[pos] = append(errorPositions[pos], *e)
-		}
-
-		errBuf := bytes.Buffer{}
-
-		for k, v := range errorPositions {
-			errBuf.WriteString(fmt.Sprintf("Error on line %d, column %d. ", k.line, k.col))
-			errBuf.WriteString("Expected one of: ")
-			expected := make(map[string]bool)
-
-			for _, e := range v {
-				for _, i := range e.Expected {
-					expected[i] = true
-				}
-			}
-
-			for i := range expected {
-				errBuf.WriteString(i)
-				errBuf.WriteString(" ")
-			}
-
-			errBuf.WriteString("\n")
-		}
-
-		return Grammar{}, fmt.Errorf(errBuf.String())
+		return Grammar{}, newSyntaxError(lex.I, err)
 	}
 
 	roots := parse.GetRoots()
@@ -0,0 +1,60 @@
+package lexer
+
+import (
+	"unicode"
+)
+
+// skipSpace returns the offset of the first rune from i on that is neither
+// whitespace nor part of a XPath 2.0 comment, "(: ... :)".  Comments nest.  An
+// unterminated comment is not skipped.
+func skipSpace(input []rune, i int) int {
+	for i < len(input) {
+		if unicode.IsSpace(input[i]) {
+			i++
+			continue
+		}
+
+		end := commentEnd(input, i)
+
+		if end < 0 {
+			return i
+		}
+
+		i = end
+	}
+
+	return i
+}
+
+// commentEnd returns the offset just past the comment that starts at i, or
+// -1 if no comment starts there, or it is never closed.
+func commentEnd(input []rune, i int) int {
+	if !pairAt(input, i, '(', ':') {
+		return -1
+	}
+
+	depth := 0
+
+	for i < len(input) {
+		switch {
+		case pairAt(input, i, '(', ':'):
+			depth++
+			i += 2
+		case pairAt(input, i, ':', ')'):
+			depth--
+			i += 2
+
+			if depth == 0 {
+				return i
+			}
+		default:
+			i++
+		}
+	}
+
+	return -1
+}
+
+func pairAt(input []rune, i int, first, second rune) bool {
+	return i+1 < len(input) && input[i] == first && input[i+1] == second
+}
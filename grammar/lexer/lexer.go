@@ -172,8 +172,13 @@ func New(input []rune) *Lexer {
 	}
 	lext := 0
 	for lext < len(lex.I) {
-		for lext < len(lex.I) && unicode.IsSpace(lex.I[lext]) {
-			lext++
+		// Whitespace and XPath 2.0 comments are skipped by skipSpace, in
+		// comments.go.  An unterminated comment is an error token running
+		// to the end of the input.
+		lext = skipSpace(lex.I, lext)
+		if pairAt(lex.I, lext, '(', ':') {
+			lex.add(token.Error, lext, len(lex.I))
+			break
 		}
 		if lext < len(lex.I) {
 			tok := lex.scan(lext)
@@ -285,7 +290,7 @@ var accept = []token.Type{
 	token.Error, 
 	token.Error, 
 	token.Error, 
-	token.T_36, 
+	token.T_43, 
 	token.Error, 
 	token.Error, 
 	token.T_1, 
@@ -296,334 +301,667 @@ var accept = []token.Type{
 	token.T_6, 
 	token.T_7, 
 	token.T_9, 
-	token.T_29, 
+	token.T_30, 
 	token.T_11, 
-	token.T_13, 
-	token.T_15, 
+	token.T_14, 
 	token.T_16, 
-	token.T_18, 
+	token.T_17, 
 	token.T_19, 
 	token.T_20, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_47, 
+	token.T_21, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_59, 
 	token.T_0, 
-	token.T_31, 
+	token.T_32, 
 	token.Error, 
-	token.T_46, 
-	token.T_44, 
+	token.T_58, 
+	token.T_53, 
 	token.Error, 
 	token.T_8, 
 	token.T_10, 
 	token.T_12, 
-	token.T_14, 
-	token.T_17, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
+	token.T_13, 
+	token.T_15, 
+	token.T_18, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
 	token.T_38, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
+	token.T_39, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_45, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_57, 
 	token.Error, 
+	token.T_53, 
+	token.T_53, 
+	token.T_43, 
+	token.T_24, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_31, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_37, 
+	token.T_40, 
+	token.T_41, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_58, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_33, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
 	token.T_44, 
-	token.T_44, 
-	token.T_36, 
-	token.T_23, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_30, 
-	token.T_36, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_52, 
+	token.T_54, 
+	token.T_55, 
+	token.T_56, 
+	token.T_58, 
+	token.T_43, 
+	token.T_43, 
+	token.T_26, 
+	token.T_43, 
+	token.T_43, 
 	token.T_34, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_46, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_37, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
 	token.T_43, 
-	token.T_45, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
 	token.T_46, 
-	token.T_36, 
-	token.T_36, 
+	token.T_43, 
+	token.T_43, 
+	token.T_50, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_27, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_22, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
 	token.T_25, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_39, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_26, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_21, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_24, 
-	token.T_36, 
-	token.T_32, 
+	token.T_43, 
 	token.T_35, 
-	token.T_40, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_27, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_22, 
-	token.T_22, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_33, 
-	token.T_33, 
-	token.T_41, 
-	token.T_41, 
-	token.T_36, 
-	token.T_36, 
-	token.T_28, 
+	token.T_42, 
+	token.T_47, 
+	token.T_43, 
+	token.T_51, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
 	token.T_28, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_23, 
+	token.T_23, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
 	token.T_36, 
 	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_36, 
-	token.T_42, 
-	token.T_42, 
+	token.T_48, 
+	token.T_48, 
+	token.T_43, 
+	token.T_43, 
+	token.T_29, 
+	token.T_29, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_43, 
+	token.T_49, 
+	token.T_49, 
 }
 
 var nextState = []func(r rune) state{ 
 	// Set0
 	func(r rune) state {
 		switch { 
-		case r == '!':
-			return 1 
-		case r == '"':
-			return 2 
+		case r == '!':
+			return 1 
+		case r == '"':
+			return 2 
+		case r == '#':
+			return 3 
+		case r == '$':
+			return 4 
+		case r == '\'':
+			return 5 
+		case r == '(':
+			return 6 
+		case r == ')':
+			return 7 
+		case r == '*':
+			return 8 
+		case r == '+':
+			return 9 
+		case r == ',':
+			return 10 
+		case r == '-':
+			return 11 
+		case r == '.':
+			return 12 
+		case r == '/':
+			return 13 
+		case r == '0':
+			return 14 
+		case r == '1':
+			return 14 
+		case r == '2':
+			return 14 
+		case r == '3':
+			return 14 
+		case r == '4':
+			return 14 
+		case r == '5':
+			return 14 
+		case r == '6':
+			return 14 
+		case r == '7':
+			return 14 
+		case r == '8':
+			return 14 
+		case r == '9':
+			return 14 
+		case r == ':':
+			return 15 
+		case r == '<':
+			return 16 
+		case r == '=':
+			return 17 
+		case r == '>':
+			return 18 
+		case r == '@':
+			return 19 
+		case r == '[':
+			return 20 
+		case r == ']':
+			return 21 
+		case r == 'a':
+			return 22 
+		case r == 'c':
+			return 23 
+		case r == 'd':
+			return 24 
+		case r == 'e':
+			return 25 
+		case r == 'f':
+			return 26 
+		case r == 'i':
+			return 27 
+		case r == 'l':
+			return 28 
+		case r == 'm':
+			return 29 
+		case r == 'n':
+			return 30 
+		case r == 'o':
+			return 31 
+		case r == 'p':
+			return 32 
+		case r == 'r':
+			return 33 
+		case r == 's':
+			return 34 
+		case r == 't':
+			return 35 
+		case r == '|':
+			return 36 
+		case unicode.IsLetter(r):
+			return 3 
+		}
+		return nullState
+	}, 
+	// Set1
+	func(r rune) state {
+		switch { 
+		case r == '=':
+			return 37 
+		}
+		return nullState
+	}, 
+	// Set2
+	func(r rune) state {
+		switch { 
+		case r == '"':
+			return 38 
+		case r == '\\':
+			return 39 
+		case not(r, []rune{'"','\\'}):
+			return 2 
+		}
+		return nullState
+	}, 
+	// Set3
+	func(r rune) state {
+		switch { 
+		case r == '#':
+			return 3 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
+		}
+		return nullState
+	}, 
+	// Set4
+	func(r rune) state {
+		switch { 
+		case r == '#':
+			return 40 
+		case unicode.IsLetter(r):
+			return 40 
+		}
+		return nullState
+	}, 
+	// Set5
+	func(r rune) state {
+		switch { 
+		case r == '\'':
+			return 41 
+		case r == '\\':
+			return 42 
+		case not(r, []rune{'\''}):
+			return 5 
+		}
+		return nullState
+	}, 
+	// Set6
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set7
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set8
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set9
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set10
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set11
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set12
+	func(r rune) state {
+		switch { 
+		case r == '.':
+			return 43 
+		}
+		return nullState
+	}, 
+	// Set13
+	func(r rune) state {
+		switch { 
+		case r == '/':
+			return 44 
+		}
+		return nullState
+	}, 
+	// Set14
+	func(r rune) state {
+		switch { 
+		case r == '0':
+			return 14 
+		case r == '1':
+			return 14 
+		case r == '2':
+			return 14 
+		case r == '3':
+			return 14 
+		case r == '4':
+			return 14 
+		case r == '5':
+			return 14 
+		case r == '6':
+			return 14 
+		case r == '7':
+			return 14 
+		case r == '8':
+			return 14 
+		case r == '9':
+			return 14 
+		}
+		return nullState
+	}, 
+	// Set15
+	func(r rune) state {
+		switch { 
+		case r == ':':
+			return 45 
+		case r == '=':
+			return 46 
+		}
+		return nullState
+	}, 
+	// Set16
+	func(r rune) state {
+		switch { 
+		case r == '=':
+			return 47 
+		}
+		return nullState
+	}, 
+	// Set17
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set18
+	func(r rune) state {
+		switch { 
+		case r == '=':
+			return 48 
+		}
+		return nullState
+	}, 
+	// Set19
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set20
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set21
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set22
+	func(r rune) state {
+		switch { 
+		case r == '#':
+			return 3 
+		case r == 'n':
+			return 49 
+		case r == 't':
+			return 50 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
+		}
+		return nullState
+	}, 
+	// Set23
+	func(r rune) state {
+		switch { 
+		case r == '#':
+			return 3 
+		case r == 'h':
+			return 51 
+		case r == 'o':
+			return 52 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
+		}
+		return nullState
+	}, 
+	// Set24
+	func(r rune) state {
+		switch { 
+		case r == '#':
+			return 3 
+		case r == 'e':
+			return 53 
+		case r == 'i':
+			return 54 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
+		}
+		return nullState
+	}, 
+	// Set25
+	func(r rune) state {
+		switch { 
+		case r == '#':
+			return 3 
+		case r == 'l':
+			return 55 
+		case r == 'v':
+			return 56 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
+		}
+		return nullState
+	}, 
+	// Set26
+	func(r rune) state {
+		switch { 
+		case r == '#':
+			return 3 
+		case r == 'o':
+			return 57 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
+		}
+		return nullState
+	}, 
+	// Set27
+	func(r rune) state {
+		switch { 
 		case r == '#':
 			return 3 
-		case r == '$':
-			return 4 
-		case r == '\'':
-			return 5 
-		case r == '(':
-			return 6 
-		case r == ')':
-			return 7 
-		case r == '*':
-			return 8 
-		case r == '+':
-			return 9 
-		case r == ',':
-			return 10 
-		case r == '-':
-			return 11 
-		case r == '.':
-			return 12 
-		case r == '/':
-			return 13 
-		case r == '0':
-			return 14 
-		case r == '1':
-			return 14 
-		case r == '2':
-			return 14 
-		case r == '3':
-			return 14 
-		case r == '4':
-			return 14 
-		case r == '5':
-			return 14 
-		case r == '6':
-			return 14 
-		case r == '7':
-			return 14 
-		case r == '8':
-			return 14 
-		case r == '9':
-			return 14 
-		case r == ':':
-			return 15 
-		case r == '<':
-			return 16 
-		case r == '=':
-			return 17 
-		case r == '>':
-			return 18 
-		case r == '@':
-			return 19 
-		case r == '[':
-			return 20 
-		case r == ']':
-			return 21 
-		case r == 'a':
-			return 22 
-		case r == 'c':
-			return 23 
-		case r == 'd':
-			return 24 
 		case r == 'f':
-			return 25 
-		case r == 'm':
-			return 26 
+			return 58 
 		case r == 'n':
-			return 27 
-		case r == 'o':
-			return 28 
-		case r == 'p':
-			return 29 
-		case r == 's':
-			return 30 
-		case r == 't':
-			return 31 
-		case r == '|':
-			return 32 
+			return 59 
 		case unicode.IsLetter(r):
 			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set1
+	// Set28
 	func(r rune) state {
 		switch { 
-		case r == '=':
-			return 33 
+		case r == '#':
+			return 3 
+		case r == 'e':
+			return 60 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set2
+	// Set29
 	func(r rune) state {
 		switch { 
-		case r == '"':
-			return 34 
-		case r == '\\':
-			return 35 
-		case not(r, []rune{'"','\\'}):
-			return 2 
+		case r == '#':
+			return 3 
+		case r == 'o':
+			return 61 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set3
+	// Set30
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
+		case r == 'a':
+			return 62 
+		case r == 'o':
+			return 63 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -631,163 +969,289 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set4
+	// Set31
 	func(r rune) state {
 		switch { 
 		case r == '#':
-			return 36 
+			return 3 
+		case r == 'r':
+			return 64 
 		case unicode.IsLetter(r):
-			return 36 
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set5
+	// Set32
 	func(r rune) state {
 		switch { 
-		case r == '\'':
-			return 37 
-		case r == '\\':
-			return 38 
-		case not(r, []rune{'\''}):
-			return 5 
+		case r == '#':
+			return 3 
+		case r == 'a':
+			return 65 
+		case r == 'r':
+			return 66 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set6
+	// Set33
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 'e':
+			return 67 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set7
+	// Set34
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 'a':
+			return 68 
+		case r == 'e':
+			return 69 
+		case r == 'o':
+			return 70 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set8
+	// Set35
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 'e':
+			return 71 
+		case r == 'h':
+			return 72 
+		case r == 'o':
+			return 73 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set9
+	// Set36
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set10
+	// Set37
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set11
+	// Set38
 	func(r rune) state {
 		switch { 
 		}
 		return nullState
 	}, 
-	// Set12
+	// Set39
 	func(r rune) state {
 		switch { 
-		case r == '.':
-			return 39 
+		case any(r, []rune{'"','\\','n','r','t'}):
+			return 2 
 		}
 		return nullState
 	}, 
-	// Set13
+	// Set40
 	func(r rune) state {
 		switch { 
-		case r == '/':
+		case r == '#':
+			return 40 
+		case r == ':':
+			return 74 
+		case unicode.IsLetter(r):
+			return 40 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
 			return 40 
 		}
 		return nullState
 	}, 
-	// Set14
+	// Set41
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set42
+	func(r rune) state {
+		switch { 
+		case r == '\'':
+			return 75 
+		case r == '\\':
+			return 42 
+		case not(r, []rune{'\''}):
+			return 5 
+		case any(r, []rune{'\'','\\','n','r','t'}):
+			return 76 
+		}
+		return nullState
+	}, 
+	// Set43
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set44
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set45
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set46
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set47
+	func(r rune) state {
+		switch { 
+		}
+		return nullState
+	}, 
+	// Set48
 	func(r rune) state {
 		switch { 
-		case r == '0':
-			return 14 
-		case r == '1':
-			return 14 
-		case r == '2':
-			return 14 
-		case r == '3':
-			return 14 
-		case r == '4':
-			return 14 
-		case r == '5':
-			return 14 
-		case r == '6':
-			return 14 
-		case r == '7':
-			return 14 
-		case r == '8':
-			return 14 
-		case r == '9':
-			return 14 
 		}
 		return nullState
 	}, 
-	// Set15
+	// Set49
 	func(r rune) state {
 		switch { 
-		case r == ':':
-			return 41 
+		case r == '#':
+			return 3 
+		case r == 'c':
+			return 77 
+		case r == 'd':
+			return 78 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set16
+	// Set50
 	func(r rune) state {
 		switch { 
-		case r == '=':
-			return 42 
+		case r == '#':
+			return 3 
+		case r == 't':
+			return 79 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set17
+	// Set51
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 'i':
+			return 80 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set18
+	// Set52
 	func(r rune) state {
 		switch { 
-		case r == '=':
-			return 43 
+		case r == '#':
+			return 3 
+		case r == 'm':
+			return 81 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set19
+	// Set53
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 's':
+			return 82 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set20
+	// Set54
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 'v':
+			return 83 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set21
+	// Set55
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 's':
+			return 84 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set22
+	// Set56
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'n':
-			return 44 
-		case r == 't':
-			return 45 
+		case r == 'e':
+			return 85 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -795,15 +1259,15 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set23
+	// Set57
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'h':
-			return 46 
-		case r == 'o':
-			return 47 
+		case r == 'l':
+			return 86 
+		case r == 'r':
+			return 87 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -811,15 +1275,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set24
+	// Set58
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'e':
-			return 48 
-		case r == 'i':
-			return 49 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -827,13 +1287,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set25
+	// Set59
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'o':
-			return 50 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -841,13 +1299,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set26
+	// Set60
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'o':
-			return 51 
+		case r == 't':
+			return 88 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -855,15 +1313,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set27
+	// Set61
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'a':
-			return 52 
-		case r == 'o':
-			return 53 
+		case r == 'd':
+			return 89 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -871,13 +1327,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set28
+	// Set62
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'r':
-			return 54 
+		case r == 'm':
+			return 90 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -885,15 +1341,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set29
+	// Set63
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'a':
-			return 55 
-		case r == 'r':
-			return 56 
+		case r == 'd':
+			return 91 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -901,13 +1355,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set30
+	// Set64
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'e':
-			return 57 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -915,13 +1367,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set31
+	// Set65
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'e':
-			return 58 
+		case r == 'r':
+			return 92 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -929,105 +1381,173 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set32
+	// Set66
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 'e':
+			return 93 
+		case r == 'o':
+			return 94 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set33
+	// Set67
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 't':
+			return 95 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set34
+	// Set68
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 't':
+			return 96 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set35
+	// Set69
 	func(r rune) state {
 		switch { 
-		case any(r, []rune{'"','\\','n','r','t'}):
-			return 2 
+		case r == '#':
+			return 3 
+		case r == 'l':
+			return 97 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set36
+	// Set70
 	func(r rune) state {
 		switch { 
 		case r == '#':
-			return 36 
-		case r == ':':
-			return 59 
+			return 3 
+		case r == 'm':
+			return 98 
 		case unicode.IsLetter(r):
-			return 36 
+			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 36 
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set37
+	// Set71
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 'x':
+			return 99 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set38
+	// Set72
 	func(r rune) state {
 		switch { 
-		case r == '\'':
-			return 60 
-		case r == '\\':
-			return 38 
-		case not(r, []rune{'\''}):
-			return 5 
-		case any(r, []rune{'\'','\\','n','r','t'}):
-			return 61 
+		case r == '#':
+			return 3 
+		case r == 'e':
+			return 100 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set39
+	// Set73
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set40
+	// Set74
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 101 
+		case unicode.IsLetter(r):
+			return 101 
 		}
 		return nullState
 	}, 
-	// Set41
+	// Set75
 	func(r rune) state {
 		switch { 
+		case r == '\'':
+			return 41 
+		case r == '\\':
+			return 42 
+		case not(r, []rune{'\''}):
+			return 5 
 		}
 		return nullState
 	}, 
-	// Set42
+	// Set76
 	func(r rune) state {
 		switch { 
+		case r == '\'':
+			return 75 
+		case r == '\\':
+			return 42 
+		case not(r, []rune{'\''}):
+			return 5 
+		case any(r, []rune{'\'','\\','n','r','t'}):
+			return 76 
 		}
 		return nullState
 	}, 
-	// Set43
+	// Set77
 	func(r rune) state {
 		switch { 
+		case r == '#':
+			return 3 
+		case r == 'e':
+			return 102 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set44
+	// Set78
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'c':
-			return 62 
-		case r == 'd':
-			return 63 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1035,13 +1555,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set45
+	// Set79
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 't':
-			return 64 
+		case r == 'r':
+			return 103 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1049,13 +1569,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set46
+	// Set80
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'i':
-			return 65 
+		case r == 'l':
+			return 104 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1063,13 +1583,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set47
+	// Set81
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'm':
-			return 66 
+			return 105 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1077,13 +1597,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set48
+	// Set82
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 's':
-			return 67 
+		case r == 'c':
+			return 106 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1091,13 +1611,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set49
+	// Set83
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'v':
-			return 68 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1105,13 +1623,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set50
+	// Set84
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'l':
-			return 69 
+		case r == 'e':
+			return 107 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1119,13 +1637,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set51
+	// Set85
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'd':
-			return 70 
+		case r == 'r':
+			return 108 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1133,13 +1651,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set52
+	// Set86
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'm':
-			return 71 
+		case r == 'l':
+			return 109 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1147,13 +1665,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set53
+	// Set87
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'd':
-			return 72 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1161,7 +1677,7 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set54
+	// Set88
 	func(r rune) state {
 		switch { 
 		case r == '#':
@@ -1173,13 +1689,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set55
+	// Set89
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'r':
-			return 73 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1187,15 +1701,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set56
+	// Set90
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'e':
-			return 74 
-		case r == 'o':
-			return 75 
+			return 110 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1203,13 +1715,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set57
+	// Set91
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'l':
-			return 76 
+		case r == 'e':
+			return 111 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1217,13 +1729,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set58
+	// Set92
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'x':
-			return 77 
+		case r == 'e':
+			return 112 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1231,49 +1743,55 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set59
+	// Set93
 	func(r rune) state {
 		switch { 
 		case r == '#':
-			return 78 
+			return 3 
+		case r == 'c':
+			return 113 
 		case unicode.IsLetter(r):
-			return 78 
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set60
+	// Set94
 	func(r rune) state {
 		switch { 
-		case r == '\'':
-			return 37 
-		case r == '\\':
-			return 38 
-		case not(r, []rune{'\''}):
-			return 5 
+		case r == '#':
+			return 3 
+		case r == 'c':
+			return 114 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set61
+	// Set95
 	func(r rune) state {
 		switch { 
-		case r == '\'':
-			return 60 
-		case r == '\\':
-			return 38 
-		case not(r, []rune{'\''}):
-			return 5 
-		case any(r, []rune{'\'','\\','n','r','t'}):
-			return 61 
+		case r == '#':
+			return 3 
+		case r == 'u':
+			return 115 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set62
+	// Set96
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'e':
-			return 79 
+		case r == 'i':
+			return 116 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1281,11 +1799,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set63
+	// Set97
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
+		case r == 'f':
+			return 117 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1293,13 +1813,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set64
+	// Set98
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'r':
-			return 80 
+		case r == 'e':
+			return 118 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1307,13 +1827,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set65
+	// Set99
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'l':
-			return 81 
+		case r == 't':
+			return 119 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1321,13 +1841,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set66
+	// Set100
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'm':
-			return 82 
+		case r == 'n':
+			return 120 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1335,25 +1855,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set67
+	// Set101
 	func(r rune) state {
 		switch { 
 		case r == '#':
-			return 3 
-		case r == 'c':
-			return 83 
+			return 121 
 		case unicode.IsLetter(r):
-			return 3 
+			return 121 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 3 
+			return 121 
 		}
 		return nullState
 	}, 
-	// Set68
+	// Set102
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
+		case r == 's':
+			return 122 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1361,13 +1881,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set69
+	// Set103
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'l':
-			return 84 
+		case r == 'i':
+			return 123 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1375,11 +1895,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set70
+	// Set104
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
+		case r == 'd':
+			return 124 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1387,13 +1909,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set71
+	// Set105
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'e':
-			return 85 
+			return 125 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1401,13 +1923,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set72
+	// Set106
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'e':
-			return 86 
+			return 126 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1415,13 +1937,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set73
+	// Set107
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'e':
-			return 87 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1429,13 +1949,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set74
+	// Set108
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'c':
-			return 88 
+		case r == 'y':
+			return 127 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1443,13 +1963,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set75
+	// Set109
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'c':
-			return 89 
+		case r == 'o':
+			return 128 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1457,13 +1977,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set76
+	// Set110
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'f':
-			return 90 
+		case r == 's':
+			return 129 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1471,13 +1991,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set77
+	// Set111
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 't':
-			return 91 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1485,25 +2003,27 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set78
+	// Set112
 	func(r rune) state {
 		switch { 
 		case r == '#':
-			return 92 
+			return 3 
+		case r == 'n':
+			return 130 
 		case unicode.IsLetter(r):
-			return 92 
+			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 92 
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set79
+	// Set113
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 's':
-			return 93 
+		case r == 'e':
+			return 131 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1511,13 +2031,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set80
+	// Set114
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'i':
-			return 94 
+		case r == 'e':
+			return 132 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1525,13 +2045,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set81
+	// Set115
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'd':
-			return 95 
+		case r == 'r':
+			return 133 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1539,13 +2059,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set82
+	// Set116
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'e':
-			return 96 
+		case r == 's':
+			return 134 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1553,13 +2073,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set83
+	// Set117
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'e':
-			return 97 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1567,13 +2085,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set84
+	// Set118
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'o':
-			return 98 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1581,13 +2097,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set85
+	// Set119
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 's':
-			return 99 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1595,7 +2109,7 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set86
+	// Set120
 	func(r rune) state {
 		switch { 
 		case r == '#':
@@ -1607,27 +2121,27 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set87
+	// Set121
 	func(r rune) state {
 		switch { 
 		case r == '#':
-			return 3 
-		case r == 'n':
-			return 100 
+			return 121 
+		case r == ':':
+			return 74 
 		case unicode.IsLetter(r):
-			return 3 
+			return 121 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 3 
+			return 121 
 		}
 		return nullState
 	}, 
-	// Set88
+	// Set122
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'e':
-			return 101 
+		case r == 't':
+			return 135 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1635,13 +2149,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set89
+	// Set123
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'e':
-			return 102 
+		case r == 'b':
+			return 136 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1649,7 +2163,7 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set90
+	// Set124
 	func(r rune) state {
 		switch { 
 		case r == '#':
@@ -1661,11 +2175,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set91
+	// Set125
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
+		case r == 'n':
+			return 137 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1673,27 +2189,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set92
+	// Set126
 	func(r rune) state {
 		switch { 
 		case r == '#':
-			return 92 
-		case r == ':':
-			return 59 
+			return 3 
+		case r == 'n':
+			return 138 
 		case unicode.IsLetter(r):
-			return 92 
+			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 92 
+			return 3 
 		}
 		return nullState
 	}, 
-	// Set93
+	// Set127
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 't':
-			return 103 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1701,13 +2215,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set94
+	// Set128
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'b':
-			return 104 
+		case r == 'w':
+			return 139 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1715,11 +2229,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set95
+	// Set129
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
+		case r == 'p':
+			return 140 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1727,13 +2243,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set96
+	// Set130
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'n':
-			return 105 
+		case r == 't':
+			return 141 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1741,13 +2257,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set97
+	// Set131
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'n':
-			return 106 
+		case r == 'd':
+			return 142 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1755,13 +2271,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set98
+	// Set132
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'w':
-			return 107 
+		case r == 's':
+			return 143 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1769,13 +2285,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set99
+	// Set133
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'p':
-			return 108 
+		case r == 'n':
+			return 144 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1783,13 +2299,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set100
+	// Set134
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 't':
-			return 109 
+		case r == 'f':
+			return 145 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1797,13 +2313,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set101
+	// Set135
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'd':
-			return 110 
+		case r == 'o':
+			return 146 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1811,13 +2327,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set102
+	// Set136
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 's':
-			return 111 
+		case r == 'u':
+			return 147 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1825,13 +2341,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set103
+	// Set137
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'o':
-			return 112 
+		case r == 't':
+			return 148 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1839,13 +2355,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set104
+	// Set138
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'u':
-			return 113 
+		case r == 'd':
+			return 149 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1853,13 +2369,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set105
+	// Set139
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 't':
-			return 114 
+		case r == 'i':
+			return 150 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1867,13 +2383,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set106
+	// Set140
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'd':
-			return 115 
+		case r == 'a':
+			return 151 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1881,13 +2397,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set107
+	// Set141
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'i':
-			return 116 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1895,13 +2409,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set108
+	// Set142
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'a':
-			return 117 
+		case r == 'i':
+			return 152 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1909,11 +2423,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set109
+	// Set143
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
+		case r == 's':
+			return 153 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1921,13 +2437,11 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set110
+	// Set144
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'i':
-			return 118 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1935,13 +2449,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set111
+	// Set145
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 's':
-			return 119 
+		case r == 'i':
+			return 154 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1949,13 +2463,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set112
+	// Set146
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'r':
-			return 120 
+			return 155 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1963,13 +2477,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set113
+	// Set147
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 't':
-			return 121 
+			return 156 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -1977,7 +2491,7 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set114
+	// Set148
 	func(r rune) state {
 		switch { 
 		case r == '#':
@@ -1989,13 +2503,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set115
+	// Set149
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'a':
-			return 122 
+			return 157 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2003,13 +2517,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set116
+	// Set150
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'n':
-			return 123 
+			return 158 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2017,13 +2531,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set117
+	// Set151
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'c':
-			return 124 
+			return 159 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2031,13 +2545,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set118
+	// Set152
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'n':
-			return 125 
+			return 160 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2045,13 +2559,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set119
+	// Set153
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'i':
-			return 126 
+			return 161 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2059,27 +2573,41 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set120
+	// Set154
+	func(r rune) state {
+		switch { 
+		case r == '#':
+			return 3 
+		case r == 'e':
+			return 162 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
+		}
+		return nullState
+	}, 
+	// Set155
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == '-':
-			return 127 
+			return 163 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 128 
+			return 164 
 		}
 		return nullState
 	}, 
-	// Set121
+	// Set156
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'e':
-			return 129 
+			return 165 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2087,13 +2615,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set122
+	// Set157
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'n':
-			return 130 
+			return 166 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2101,13 +2629,27 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set123
+	// Set158
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'g':
-			return 131 
+			return 167 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
+		}
+		return nullState
+	}, 
+	// Set159
+	func(r rune) state {
+		switch { 
+		case r == '#':
+			return 3 
+		case r == 'e':
+			return 168 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2115,13 +2657,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set124
+	// Set160
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'e':
-			return 132 
+		case r == 'g':
+			return 169 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2129,13 +2671,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set125
+	// Set161
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'g':
-			return 133 
+		case r == 'n':
+			return 170 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2143,13 +2685,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set126
+	// Set162
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
-		case r == 'n':
-			return 134 
+		case r == 's':
+			return 171 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2157,25 +2699,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set127
+	// Set163
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 135 
+			return 172 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set128
+	// Set164
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'o':
-			return 137 
+			return 174 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2183,7 +2725,7 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set129
+	// Set165
 	func(r rune) state {
 		switch { 
 		case r == '#':
@@ -2195,13 +2737,13 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set130
+	// Set166
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 't':
-			return 138 
+			return 175 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2209,21 +2751,21 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set131
+	// Set167
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == '-':
-			return 139 
+			return 176 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 140 
+			return 177 
 		}
 		return nullState
 	}, 
-	// Set132
+	// Set168
 	func(r rune) state {
 		switch { 
 		case r == '#':
@@ -2235,27 +2777,27 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set133
+	// Set169
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == '-':
-			return 141 
+			return 178 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 142 
+			return 179 
 		}
 		return nullState
 	}, 
-	// Set134
+	// Set170
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'g':
-			return 143 
+			return 180 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2263,35 +2805,47 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set135
+	// Set171
+	func(r rune) state {
+		switch { 
+		case r == '#':
+			return 3 
+		case unicode.IsLetter(r):
+			return 3 
+		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
+			return 3 
+		}
+		return nullState
+	}, 
+	// Set172
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 144 
+			return 181 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set136
+	// Set173
 	func(r rune) state {
 		switch { 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set137
+	// Set174
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'r':
-			return 145 
+			return 182 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2299,39 +2853,39 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set138
+	// Set175
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == '-':
-			return 146 
+			return 183 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 147 
+			return 184 
 		}
 		return nullState
 	}, 
-	// Set139
+	// Set176
 	func(r rune) state {
 		switch { 
 		case r == 's':
-			return 148 
+			return 185 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set140
+	// Set177
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 's':
-			return 149 
+			return 186 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2339,25 +2893,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set141
+	// Set178
 	func(r rune) state {
 		switch { 
 		case r == 's':
-			return 150 
+			return 187 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set142
+	// Set179
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 's':
-			return 151 
+			return 188 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2365,65 +2919,65 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set143
+	// Set180
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == '-':
-			return 152 
+			return 189 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 153 
+			return 190 
 		}
 		return nullState
 	}, 
-	// Set144
+	// Set181
 	func(r rune) state {
 		switch { 
 		case r == '-':
-			return 154 
+			return 191 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 154 
+			return 191 
 		}
 		return nullState
 	}, 
-	// Set145
+	// Set182
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == '-':
-			return 154 
+			return 191 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 155 
+			return 192 
 		}
 		return nullState
 	}, 
-	// Set146
+	// Set183
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 156 
+			return 193 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set147
+	// Set184
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'o':
-			return 157 
+			return 194 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2431,25 +2985,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set148
+	// Set185
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 158 
+			return 195 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set149
+	// Set186
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'i':
-			return 159 
+			return 196 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2457,25 +3011,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set150
+	// Set187
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 160 
+			return 197 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set151
+	// Set188
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'i':
-			return 161 
+			return 198 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2483,25 +3037,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set152
+	// Set189
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 162 
+			return 199 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set153
+	// Set190
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'i':
-			return 163 
+			return 200 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2509,25 +3063,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set154
+	// Set191
 	func(r rune) state {
 		switch { 
 		case r == 's':
-			return 164 
+			return 201 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set155
+	// Set192
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 's':
-			return 165 
+			return 202 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2535,25 +3089,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set156
+	// Set193
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 166 
+			return 203 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set157
+	// Set194
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'r':
-			return 167 
+			return 204 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2561,25 +3115,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set158
+	// Set195
 	func(r rune) state {
 		switch { 
 		case r == 'b':
-			return 168 
+			return 205 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set159
+	// Set196
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'b':
-			return 169 
+			return 206 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2587,25 +3141,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set160
+	// Set197
 	func(r rune) state {
 		switch { 
 		case r == 'b':
-			return 170 
+			return 207 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set161
+	// Set198
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'b':
-			return 171 
+			return 208 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2613,25 +3167,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set162
+	// Set199
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 172 
+			return 209 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set163
+	// Set200
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'n':
-			return 173 
+			return 210 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2639,25 +3193,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set164
+	// Set201
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 174 
+			return 211 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set165
+	// Set202
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'e':
-			return 175 
+			return 212 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2665,51 +3219,51 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set166
+	// Set203
 	func(r rune) state {
 		switch { 
 		case r == '-':
-			return 176 
+			return 213 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 176 
+			return 213 
 		}
 		return nullState
 	}, 
-	// Set167
+	// Set204
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == '-':
-			return 176 
+			return 213 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 177 
+			return 214 
 		}
 		return nullState
 	}, 
-	// Set168
+	// Set205
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 178 
+			return 215 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set169
+	// Set206
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'l':
-			return 179 
+			return 216 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2717,25 +3271,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set170
+	// Set207
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 180 
+			return 217 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set171
+	// Set208
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'l':
-			return 181 
+			return 218 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2743,25 +3297,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set172
+	// Set209
 	func(r rune) state {
 		switch { 
 		case r == 's':
-			return 182 
+			return 219 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set173
+	// Set210
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 's':
-			return 183 
+			return 220 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2769,25 +3323,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set174
+	// Set211
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 184 
+			return 221 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set175
+	// Set212
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'l':
-			return 185 
+			return 222 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2795,25 +3349,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set176
+	// Set213
 	func(r rune) state {
 		switch { 
 		case r == 's':
-			return 186 
+			return 223 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set177
+	// Set214
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 's':
-			return 187 
+			return 224 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2821,25 +3375,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set178
+	// Set215
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 188 
+			return 225 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set179
+	// Set216
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'i':
-			return 189 
+			return 226 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2847,25 +3401,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set180
+	// Set217
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 190 
+			return 227 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set181
+	// Set218
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'i':
-			return 191 
+			return 228 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2873,25 +3427,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set182
+	// Set219
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 192 
+			return 229 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set183
+	// Set220
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 't':
-			return 193 
+			return 230 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2899,25 +3453,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set184
+	// Set221
 	func(r rune) state {
 		switch { 
 		case r == 'f':
-			return 194 
+			return 231 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set185
+	// Set222
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'f':
-			return 195 
+			return 232 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2925,25 +3479,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set186
+	// Set223
 	func(r rune) state {
 		switch { 
 		case r == 'e':
-			return 196 
+			return 233 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set187
+	// Set224
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'e':
-			return 197 
+			return 234 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2951,25 +3505,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set188
+	// Set225
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 198 
+			return 235 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set189
+	// Set226
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'n':
-			return 199 
+			return 236 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -2977,25 +3531,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set190
+	// Set227
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 200 
+			return 237 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set191
+	// Set228
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'n':
-			return 201 
+			return 238 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3003,25 +3557,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set192
+	// Set229
 	func(r rune) state {
 		switch { 
 		case r == 'r':
-			return 202 
+			return 239 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set193
+	// Set230
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'r':
-			return 203 
+			return 240 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3029,17 +3583,17 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set194
+	// Set231
 	func(r rune) state {
 		switch { 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set195
+	// Set232
 	func(r rune) state {
 		switch { 
 		case r == '#':
@@ -3051,25 +3605,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set196
+	// Set233
 	func(r rune) state {
 		switch { 
 		case r == 'l':
-			return 204 
+			return 241 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set197
+	// Set234
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'l':
-			return 205 
+			return 242 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3077,25 +3631,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set198
+	// Set235
 	func(r rune) state {
 		switch { 
 		case r == 'g':
-			return 206 
+			return 243 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set199
+	// Set236
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'g':
-			return 207 
+			return 244 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3103,25 +3657,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set200
+	// Set237
 	func(r rune) state {
 		switch { 
 		case r == 'g':
-			return 208 
+			return 245 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set201
+	// Set238
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'g':
-			return 209 
+			return 246 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3129,25 +3683,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set202
+	// Set239
 	func(r rune) state {
 		switch { 
 		case r == 'u':
-			return 210 
+			return 247 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set203
+	// Set240
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'u':
-			return 211 
+			return 248 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3155,25 +3709,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set204
+	// Set241
 	func(r rune) state {
 		switch { 
 		case r == 'f':
-			return 212 
+			return 249 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set205
+	// Set242
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'f':
-			return 213 
+			return 250 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3181,17 +3735,17 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set206
+	// Set243
 	func(r rune) state {
 		switch { 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set207
+	// Set244
 	func(r rune) state {
 		switch { 
 		case r == '#':
@@ -3203,17 +3757,17 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set208
+	// Set245
 	func(r rune) state {
 		switch { 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set209
+	// Set246
 	func(r rune) state {
 		switch { 
 		case r == '#':
@@ -3225,25 +3779,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set210
+	// Set247
 	func(r rune) state {
 		switch { 
 		case r == 'c':
-			return 214 
+			return 251 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set211
+	// Set248
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'c':
-			return 215 
+			return 252 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3251,17 +3805,17 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set212
+	// Set249
 	func(r rune) state {
 		switch { 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set213
+	// Set250
 	func(r rune) state {
 		switch { 
 		case r == '#':
@@ -3273,25 +3827,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set214
+	// Set251
 	func(r rune) state {
 		switch { 
 		case r == 't':
-			return 216 
+			return 253 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set215
+	// Set252
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 't':
-			return 217 
+			return 254 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3299,25 +3853,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set216
+	// Set253
 	func(r rune) state {
 		switch { 
 		case r == 'i':
-			return 218 
+			return 255 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set217
+	// Set254
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'i':
-			return 219 
+			return 256 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3325,25 +3879,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set218
+	// Set255
 	func(r rune) state {
 		switch { 
 		case r == 'o':
-			return 220 
+			return 257 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set219
+	// Set256
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'o':
-			return 221 
+			return 258 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3351,25 +3905,25 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set220
+	// Set257
 	func(r rune) state {
 		switch { 
 		case r == 'n':
-			return 222 
+			return 259 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set221
+	// Set258
 	func(r rune) state {
 		switch { 
 		case r == '#':
 			return 3 
 		case r == 'n':
-			return 223 
+			return 260 
 		case unicode.IsLetter(r):
 			return 3 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
@@ -3377,17 +3931,17 @@ var nextState = []func(r rune) state{
 		}
 		return nullState
 	}, 
-	// Set222
+	// Set259
 	func(r rune) state {
 		switch { 
 		case unicode.IsLetter(r):
-			return 136 
+			return 173 
 		case any(r, []rune{'#','-','.','0','1','2','3','4','5','6','7','8','9','_','·','̀','́','̂','̃','̄','̅','̆','̇','̈','̉','̊','̋','̌','̍','̎','̏','̐','̑','̒','̓','̔','̕','̖','̗','̘','̙','̚','̛','̜','̝','̞','̟','̠','̡','̢','̣','̤','̥','̦','̧','̨','̩','̪','̫','̬','̭','̮','̯','̰','̱','̲','̳','̴','̵','̶','̷','̸','̹','̺','̻','̼','̽','̾','̿','̀','́','͂','̓','̈́','ͅ','͠','͡','·','҃','҄','҅','҆','֑','֒','֓','֔','֕','֖','֗','֘','֙','֚','֛','֜','֝','֞','֟','֠','֡','֣','֤','֥','֦','֧','֨','֩','֪','֫','֬','֭','֮','֯','ְ','ֱ','ֲ','ֳ','ִ','ֵ','ֶ','ַ','ָ','ֹ','ֻ','ּ','ֽ','ֿ','ׁ','ׂ','ׄ','ً','ٌ','ٍ','َ','ُ','ِ','ّ','ْ','٠','١','٢','٣','٤','٥','٦','٧','٨','٩','ٰ','ۖ','ۗ','ۘ','ۙ','ۚ','ۛ','ۜ','۝','۞','۟','۠','ۡ','ۢ','ۣ','ۤ','ۧ','ۨ','۪','۫','۬','ۭ','۰','۱','۲','۳','۴','۵','۶','۷','۸','۹','ँ','ं','ः','़','ा','ि','ी','ु','ू','ृ','ॄ','ॅ','ॆ','े','ै','ॉ','ॊ','ो','ौ','्','॑','॒','॓','॔','ॢ','ॣ','०','१','२','३','४','५','६','७','८','९','ঁ','ং','ঃ','়','া','ি','ী','ু','ূ','ৃ','ৄ','ে','ৈ','ো','ৌ','্','ৗ','ৢ','ৣ','০','১','২','৩','৪','৫','৬','৭','৮','৯','ਂ','਼','ਾ','ਿ','ੀ','ੁ','ੂ','ੇ','ੈ','ੋ','ੌ','੍','੦','੧','੨','੩','੪','੫','੬','੭','੮','੯','ੰ','ੱ','ઁ','ં','ઃ','઼','ા','િ','ી','ુ','ૂ','ૃ','ૄ','ૅ','ે','ૈ','ૉ','ો','ૌ','્','૦','૧','૨','૩','૪','૫','૬','૭','૮','૯','ଁ','ଂ','ଃ','଼','ା','ି','ୀ','ୁ','ୂ','ୃ','େ','ୈ','ୋ','ୌ','୍','ୖ','ୗ','୦','୧','୨','୩','୪','୫','୬','୭','୮','୯','ஂ','ா','ி','ீ','ு','ூ','ெ','ே','ை','ொ','ோ','ௌ','்','ௗ','௧','௨','௩','௪','௫','௬','௭','௮','௯','ఁ','ం','ః','ా','ి','ీ','ు','ూ','ృ','ౄ','ె','ే','ై','ొ','ో','ౌ','్','ౕ','ౖ','౦','౧','౨','౩','౪','౫','౬','౭','౮','౯','ಂ','ಃ','ಾ','ಿ','ೀ','ು','ೂ','ೃ','ೄ','ೆ','ೇ','ೈ','ೊ','ೋ','ೌ','್','ೕ','ೖ','೦','೧','೨','೩','೪','೫','೬','೭','೮','೯','ം','ഃ','ാ','ി','ീ','ു','ൂ','ൃ','െ','േ','ൈ','ൊ','ോ','ൌ','്','ൗ','൦','൧','൨','൩','൪','൫','൬','൭','൮','൯','ั','ิ','ี','ึ','ื','ุ','ู','ฺ','็','่','้','๊','๋','์','ํ','๎','๐','๑','๒','๓','๔','๕','๖','๗','๘','๙','ັ','ິ','ີ','ຶ','ື','ຸ','ູ','ົ','ຼ','່','້','໊','໋','໌','ໍ','໐','໑','໒','໓','໔','໕','໖','໗','໘','໙','༘','༙','༠','༡','༢','༣','༤','༥','༦','༧','༨','༩','༵','༷','༹','༾','༿','ཱ','ི','ཱི','ུ','ཱུ','ྲྀ','ཷ','ླྀ','ཹ','ེ','ཻ','ོ','ཽ','ཾ','ཿ','ྀ','ཱྀ','ྂ','ྃ','྄','྆','྇','ྐ','ྑ','ྒ','ྒྷ','ྔ','ྕ','ྗ','ྙ','ྚ','ྛ','ྜ','ྜྷ','ྞ','ྟ','ྠ','ྡ','ྡྷ','ྣ','ྤ','ྥ','ྦ','ྦྷ','ྨ','ྩ','ྪ','ྫ','ྫྷ','ྭ','ྱ','ྲ','ླ','ྴ','ྵ','ྶ','ྷ','ྐྵ','⃐','⃑','⃒','⃓','⃔','⃕','⃖','⃗','⃘','⃙','⃚','⃛','⃜','⃡','℮','ↀ','ↁ','ↂ','〇','〡','〢','〣','〤','〥','〦','〧','〨','〩','〪','〫','〬','〭','〮','〯','゙','゚'}):
-			return 136 
+			return 173 
 		}
 		return nullState
 	}, 
-	// Set223
+	// Set260
 	func(r rune) state {
 		switch { 
 		case r == '#':
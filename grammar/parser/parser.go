@@ -38,10 +38,10 @@ func newParser(l *lexer.Lexer) *parser {
 		U:      &descriptors{},
 		popped: make(map[poppedNode]bool),
 		crf: map[clusterNode][]*crfNode{
-			{symbols.NT_OrExpr, 0}: {},
+			{symbols.NT_Expr, 0}: {},
 		},
 		crfNodes:    map[crfNode]*crfNode{},
-		bsrSet:      bsr.New(symbols.NT_OrExpr, l),
+		bsrSet:      bsr.New(symbols.NT_Expr, l),
 		parseErrors: nil,
 	}
 }
@@ -55,7 +55,7 @@ func Parse(l *lexer.Lexer) (*bsr.Set, []*Error) {
 func (p *parser) parse() (*bsr.Set, []*Error) {
 	var L slot.Label
 	m, cU := len(p.lex.Tokens)-1, 0
-	p.ntAdd(symbols.NT_OrExpr, 0)
+	p.ntAdd(symbols.NT_Expr, 0)
 	// p.DumpDescriptors()
 	for !p.R.empty() {
 		L, cU, p.cI = p.R.remove()
@@ -569,6 +569,101 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.EqualityExprNotEqual0R0, p.cI, followSets[symbols.NT_EqualityExprNotEqual])
 			}
+		case slot.Expr0R0: // Expr : ∙ExprSingle
+
+			p.call(slot.Expr0R1, cU, p.cI)
+		case slot.Expr0R1: // Expr : ExprSingle ∙
+
+			if p.follow(symbols.NT_Expr) {
+				p.rtn(symbols.NT_Expr, cU, p.cI)
+			} else {
+				p.parseError(slot.Expr0R0, p.cI, followSets[symbols.NT_Expr])
+			}
+		case slot.Expr1R0: // Expr : ∙ExprComma
+
+			p.call(slot.Expr1R1, cU, p.cI)
+		case slot.Expr1R1: // Expr : ExprComma ∙
+
+			if p.follow(symbols.NT_Expr) {
+				p.rtn(symbols.NT_Expr, cU, p.cI)
+			} else {
+				p.parseError(slot.Expr1R0, p.cI, followSets[symbols.NT_Expr])
+			}
+		case slot.ExprComma0R0: // ExprComma : ∙ExprSingle , Expr
+
+			p.call(slot.ExprComma0R1, cU, p.cI)
+		case slot.ExprComma0R1: // ExprComma : ExprSingle ∙, Expr
+
+			if !p.testSelect(slot.ExprComma0R1) {
+				p.parseError(slot.ExprComma0R1, p.cI, first[slot.ExprComma0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.ExprComma0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.ExprComma0R2) {
+				p.parseError(slot.ExprComma0R2, p.cI, first[slot.ExprComma0R2])
+				break
+			}
+
+			p.call(slot.ExprComma0R3, cU, p.cI)
+		case slot.ExprComma0R3: // ExprComma : ExprSingle , Expr ∙
+
+			if p.follow(symbols.NT_ExprComma) {
+				p.rtn(symbols.NT_ExprComma, cU, p.cI)
+			} else {
+				p.parseError(slot.ExprComma0R0, p.cI, followSets[symbols.NT_ExprComma])
+			}
+		case slot.ExprSingle0R0: // ExprSingle : ∙OrExpr
+
+			p.call(slot.ExprSingle0R1, cU, p.cI)
+		case slot.ExprSingle0R1: // ExprSingle : OrExpr ∙
+
+			if p.follow(symbols.NT_ExprSingle) {
+				p.rtn(symbols.NT_ExprSingle, cU, p.cI)
+			} else {
+				p.parseError(slot.ExprSingle0R0, p.cI, followSets[symbols.NT_ExprSingle])
+			}
+		case slot.ExprSingle1R0: // ExprSingle : ∙IfExpr
+
+			p.call(slot.ExprSingle1R1, cU, p.cI)
+		case slot.ExprSingle1R1: // ExprSingle : IfExpr ∙
+
+			if p.follow(symbols.NT_ExprSingle) {
+				p.rtn(symbols.NT_ExprSingle, cU, p.cI)
+			} else {
+				p.parseError(slot.ExprSingle1R0, p.cI, followSets[symbols.NT_ExprSingle])
+			}
+		case slot.ExprSingle2R0: // ExprSingle : ∙LetExpr
+
+			p.call(slot.ExprSingle2R1, cU, p.cI)
+		case slot.ExprSingle2R1: // ExprSingle : LetExpr ∙
+
+			if p.follow(symbols.NT_ExprSingle) {
+				p.rtn(symbols.NT_ExprSingle, cU, p.cI)
+			} else {
+				p.parseError(slot.ExprSingle2R0, p.cI, followSets[symbols.NT_ExprSingle])
+			}
+		case slot.ExprSingle3R0: // ExprSingle : ∙ForExpr
+
+			p.call(slot.ExprSingle3R1, cU, p.cI)
+		case slot.ExprSingle3R1: // ExprSingle : ForExpr ∙
+
+			if p.follow(symbols.NT_ExprSingle) {
+				p.rtn(symbols.NT_ExprSingle, cU, p.cI)
+			} else {
+				p.parseError(slot.ExprSingle3R0, p.cI, followSets[symbols.NT_ExprSingle])
+			}
+		case slot.ExprSingle4R0: // ExprSingle : ∙QuantifiedExpr
+
+			p.call(slot.ExprSingle4R1, cU, p.cI)
+		case slot.ExprSingle4R1: // ExprSingle : QuantifiedExpr ∙
+
+			if p.follow(symbols.NT_ExprSingle) {
+				p.rtn(symbols.NT_ExprSingle, cU, p.cI)
+			} else {
+				p.parseError(slot.ExprSingle4R0, p.cI, followSets[symbols.NT_ExprSingle])
+			}
 		case slot.FilterExpr0R0: // FilterExpr : ∙PrimaryExpr
 
 			p.call(slot.FilterExpr0R1, cU, p.cI)
@@ -607,6 +702,53 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.FilterExprWithPredicate0R0, p.cI, followSets[symbols.NT_FilterExprWithPredicate])
 			}
+		case slot.ForExpr0R0: // ForExpr : ∙for VariableReference in ExprSingle return ExprSingle
+
+			p.bsrSet.Add(slot.ForExpr0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.ForExpr0R1) {
+				p.parseError(slot.ForExpr0R1, p.cI, first[slot.ForExpr0R1])
+				break
+			}
+
+			p.call(slot.ForExpr0R2, cU, p.cI)
+		case slot.ForExpr0R2: // ForExpr : for VariableReference ∙in ExprSingle return ExprSingle
+
+			if !p.testSelect(slot.ForExpr0R2) {
+				p.parseError(slot.ForExpr0R2, p.cI, first[slot.ForExpr0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.ForExpr0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.ForExpr0R3) {
+				p.parseError(slot.ForExpr0R3, p.cI, first[slot.ForExpr0R3])
+				break
+			}
+
+			p.call(slot.ForExpr0R4, cU, p.cI)
+		case slot.ForExpr0R4: // ForExpr : for VariableReference in ExprSingle ∙return ExprSingle
+
+			if !p.testSelect(slot.ForExpr0R4) {
+				p.parseError(slot.ForExpr0R4, p.cI, first[slot.ForExpr0R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.ForExpr0R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.ForExpr0R5) {
+				p.parseError(slot.ForExpr0R5, p.cI, first[slot.ForExpr0R5])
+				break
+			}
+
+			p.call(slot.ForExpr0R6, cU, p.cI)
+		case slot.ForExpr0R6: // ForExpr : for VariableReference in ExprSingle return ExprSingle ∙
+
+			if p.follow(symbols.NT_ForExpr) {
+				p.rtn(symbols.NT_ForExpr, cU, p.cI)
+			} else {
+				p.parseError(slot.ForExpr0R0, p.cI, followSets[symbols.NT_ForExpr])
+			}
 		case slot.FunctionCall0R0: // FunctionCall : ∙QName ( FunctionSignature
 
 			p.call(slot.FunctionCall0R1, cU, p.cI)
@@ -652,10 +794,10 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.FunctionCallArgumentList1R0, p.cI, followSets[symbols.NT_FunctionCallArgumentList])
 			}
-		case slot.FunctionCallArgumentListArgWithNext0R0: // FunctionCallArgumentListArgWithNext : ∙OrExpr , FunctionCallArgumentList
+		case slot.FunctionCallArgumentListArgWithNext0R0: // FunctionCallArgumentListArgWithNext : ∙ExprSingle , FunctionCallArgumentList
 
 			p.call(slot.FunctionCallArgumentListArgWithNext0R1, cU, p.cI)
-		case slot.FunctionCallArgumentListArgWithNext0R1: // FunctionCallArgumentListArgWithNext : OrExpr ∙, FunctionCallArgumentList
+		case slot.FunctionCallArgumentListArgWithNext0R1: // FunctionCallArgumentListArgWithNext : ExprSingle ∙, FunctionCallArgumentList
 
 			if !p.testSelect(slot.FunctionCallArgumentListArgWithNext0R1) {
 				p.parseError(slot.FunctionCallArgumentListArgWithNext0R1, p.cI, first[slot.FunctionCallArgumentListArgWithNext0R1])
@@ -670,17 +812,17 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			}
 
 			p.call(slot.FunctionCallArgumentListArgWithNext0R3, cU, p.cI)
-		case slot.FunctionCallArgumentListArgWithNext0R3: // FunctionCallArgumentListArgWithNext : OrExpr , FunctionCallArgumentList ∙
+		case slot.FunctionCallArgumentListArgWithNext0R3: // FunctionCallArgumentListArgWithNext : ExprSingle , FunctionCallArgumentList ∙
 
 			if p.follow(symbols.NT_FunctionCallArgumentListArgWithNext) {
 				p.rtn(symbols.NT_FunctionCallArgumentListArgWithNext, cU, p.cI)
 			} else {
 				p.parseError(slot.FunctionCallArgumentListArgWithNext0R0, p.cI, followSets[symbols.NT_FunctionCallArgumentListArgWithNext])
 			}
-		case slot.FunctionCallArgumentListEndArg0R0: // FunctionCallArgumentListEndArg : ∙OrExpr )
+		case slot.FunctionCallArgumentListEndArg0R0: // FunctionCallArgumentListEndArg : ∙ExprSingle )
 
 			p.call(slot.FunctionCallArgumentListEndArg0R1, cU, p.cI)
-		case slot.FunctionCallArgumentListEndArg0R1: // FunctionCallArgumentListEndArg : OrExpr ∙)
+		case slot.FunctionCallArgumentListEndArg0R1: // FunctionCallArgumentListEndArg : ExprSingle ∙)
 
 			if !p.testSelect(slot.FunctionCallArgumentListEndArg0R1) {
 				p.parseError(slot.FunctionCallArgumentListEndArg0R1, p.cI, first[slot.FunctionCallArgumentListEndArg0R1])
@@ -723,6 +865,114 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.FunctionSignatureNoArgs0R0, p.cI, followSets[symbols.NT_FunctionSignatureNoArgs])
 			}
+		case slot.IfExpr0R0: // IfExpr : ∙if ( Expr ) then ExprSingle else ExprSingle
+
+			p.bsrSet.Add(slot.IfExpr0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.IfExpr0R1) {
+				p.parseError(slot.IfExpr0R1, p.cI, first[slot.IfExpr0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.IfExpr0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.IfExpr0R2) {
+				p.parseError(slot.IfExpr0R2, p.cI, first[slot.IfExpr0R2])
+				break
+			}
+
+			p.call(slot.IfExpr0R3, cU, p.cI)
+		case slot.IfExpr0R3: // IfExpr : if ( Expr ∙) then ExprSingle else ExprSingle
+
+			if !p.testSelect(slot.IfExpr0R3) {
+				p.parseError(slot.IfExpr0R3, p.cI, first[slot.IfExpr0R3])
+				break
+			}
+
+			p.bsrSet.Add(slot.IfExpr0R4, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.IfExpr0R4) {
+				p.parseError(slot.IfExpr0R4, p.cI, first[slot.IfExpr0R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.IfExpr0R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.IfExpr0R5) {
+				p.parseError(slot.IfExpr0R5, p.cI, first[slot.IfExpr0R5])
+				break
+			}
+
+			p.call(slot.IfExpr0R6, cU, p.cI)
+		case slot.IfExpr0R6: // IfExpr : if ( Expr ) then ExprSingle ∙else ExprSingle
+
+			if !p.testSelect(slot.IfExpr0R6) {
+				p.parseError(slot.IfExpr0R6, p.cI, first[slot.IfExpr0R6])
+				break
+			}
+
+			p.bsrSet.Add(slot.IfExpr0R7, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.IfExpr0R7) {
+				p.parseError(slot.IfExpr0R7, p.cI, first[slot.IfExpr0R7])
+				break
+			}
+
+			p.call(slot.IfExpr0R8, cU, p.cI)
+		case slot.IfExpr0R8: // IfExpr : if ( Expr ) then ExprSingle else ExprSingle ∙
+
+			if p.follow(symbols.NT_IfExpr) {
+				p.rtn(symbols.NT_IfExpr, cU, p.cI)
+			} else {
+				p.parseError(slot.IfExpr0R0, p.cI, followSets[symbols.NT_IfExpr])
+			}
+		case slot.LetExpr0R0: // LetExpr : ∙let VariableReference := ExprSingle return ExprSingle
+
+			p.bsrSet.Add(slot.LetExpr0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.LetExpr0R1) {
+				p.parseError(slot.LetExpr0R1, p.cI, first[slot.LetExpr0R1])
+				break
+			}
+
+			p.call(slot.LetExpr0R2, cU, p.cI)
+		case slot.LetExpr0R2: // LetExpr : let VariableReference ∙:= ExprSingle return ExprSingle
+
+			if !p.testSelect(slot.LetExpr0R2) {
+				p.parseError(slot.LetExpr0R2, p.cI, first[slot.LetExpr0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.LetExpr0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.LetExpr0R3) {
+				p.parseError(slot.LetExpr0R3, p.cI, first[slot.LetExpr0R3])
+				break
+			}
+
+			p.call(slot.LetExpr0R4, cU, p.cI)
+		case slot.LetExpr0R4: // LetExpr : let VariableReference := ExprSingle ∙return ExprSingle
+
+			if !p.testSelect(slot.LetExpr0R4) {
+				p.parseError(slot.LetExpr0R4, p.cI, first[slot.LetExpr0R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.LetExpr0R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.LetExpr0R5) {
+				p.parseError(slot.LetExpr0R5, p.cI, first[slot.LetExpr0R5])
+				break
+			}
+
+			p.call(slot.LetExpr0R6, cU, p.cI)
+		case slot.LetExpr0R6: // LetExpr : let VariableReference := ExprSingle return ExprSingle ∙
+
+			if p.follow(symbols.NT_LetExpr) {
+				p.rtn(symbols.NT_LetExpr, cU, p.cI)
+			} else {
+				p.parseError(slot.LetExpr0R0, p.cI, followSets[symbols.NT_LetExpr])
+			}
 		case slot.Literal0R0: // Literal : ∙singlequote
 
 			p.bsrSet.Add(slot.Literal0R1, cU, p.cI, p.cI+1)
@@ -1466,6 +1716,16 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.PathExpr3R0, p.cI, followSets[symbols.NT_PathExpr])
 			}
+		case slot.PathExpr4R0: // PathExpr : ∙PathExprFilterWithExprStep
+
+			p.call(slot.PathExpr4R1, cU, p.cI)
+		case slot.PathExpr4R1: // PathExpr : PathExprFilterWithExprStep ∙
+
+			if p.follow(symbols.NT_PathExpr) {
+				p.rtn(symbols.NT_PathExpr, cU, p.cI)
+			} else {
+				p.parseError(slot.PathExpr4R0, p.cI, followSets[symbols.NT_PathExpr])
+			}
 		case slot.PathExprFilterWithAbbreviatedPath0R0: // PathExprFilterWithAbbreviatedPath : ∙FilterExpr // RelativeLocationPath
 
 			p.call(slot.PathExprFilterWithAbbreviatedPath0R1, cU, p.cI)
@@ -1491,6 +1751,45 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.PathExprFilterWithAbbreviatedPath0R0, p.cI, followSets[symbols.NT_PathExprFilterWithAbbreviatedPath])
 			}
+		case slot.PathExprFilterWithExprStep0R0: // PathExprFilterWithExprStep : ∙FilterExpr / ( Expr )
+
+			p.call(slot.PathExprFilterWithExprStep0R1, cU, p.cI)
+		case slot.PathExprFilterWithExprStep0R1: // PathExprFilterWithExprStep : FilterExpr ∙/ ( Expr )
+
+			if !p.testSelect(slot.PathExprFilterWithExprStep0R1) {
+				p.parseError(slot.PathExprFilterWithExprStep0R1, p.cI, first[slot.PathExprFilterWithExprStep0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.PathExprFilterWithExprStep0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.PathExprFilterWithExprStep0R2) {
+				p.parseError(slot.PathExprFilterWithExprStep0R2, p.cI, first[slot.PathExprFilterWithExprStep0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.PathExprFilterWithExprStep0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.PathExprFilterWithExprStep0R3) {
+				p.parseError(slot.PathExprFilterWithExprStep0R3, p.cI, first[slot.PathExprFilterWithExprStep0R3])
+				break
+			}
+
+			p.call(slot.PathExprFilterWithExprStep0R4, cU, p.cI)
+		case slot.PathExprFilterWithExprStep0R4: // PathExprFilterWithExprStep : FilterExpr / ( Expr ∙)
+
+			if !p.testSelect(slot.PathExprFilterWithExprStep0R4) {
+				p.parseError(slot.PathExprFilterWithExprStep0R4, p.cI, first[slot.PathExprFilterWithExprStep0R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.PathExprFilterWithExprStep0R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_PathExprFilterWithExprStep) {
+				p.rtn(symbols.NT_PathExprFilterWithExprStep, cU, p.cI)
+			} else {
+				p.parseError(slot.PathExprFilterWithExprStep0R0, p.cI, followSets[symbols.NT_PathExprFilterWithExprStep])
+			}
 		case slot.PathExprFilterWithPath0R0: // PathExprFilterWithPath : ∙FilterExpr / RelativeLocationPath
 
 			p.call(slot.PathExprFilterWithPath0R1, cU, p.cI)
@@ -1516,7 +1815,7 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.PathExprFilterWithPath0R0, p.cI, followSets[symbols.NT_PathExprFilterWithPath])
 			}
-		case slot.Predicate0R0: // Predicate : ∙[ OrExpr ]
+		case slot.Predicate0R0: // Predicate : ∙[ Expr ]
 
 			p.bsrSet.Add(slot.Predicate0R1, cU, p.cI, p.cI+1)
 			p.cI++
@@ -1526,7 +1825,7 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			}
 
 			p.call(slot.Predicate0R2, cU, p.cI)
-		case slot.Predicate0R2: // Predicate : [ OrExpr ∙]
+		case slot.Predicate0R2: // Predicate : [ Expr ∙]
 
 			if !p.testSelect(slot.Predicate0R2) {
 				p.parseError(slot.Predicate0R2, p.cI, first[slot.Predicate0R2])
@@ -1550,47 +1849,73 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.PrimaryExpr0R0, p.cI, followSets[symbols.NT_PrimaryExpr])
 			}
-		case slot.PrimaryExpr1R0: // PrimaryExpr : ∙Literal
+		case slot.PrimaryExpr1R0: // PrimaryExpr : ∙PrimaryExprEmptySequence
 
 			p.call(slot.PrimaryExpr1R1, cU, p.cI)
-		case slot.PrimaryExpr1R1: // PrimaryExpr : Literal ∙
+		case slot.PrimaryExpr1R1: // PrimaryExpr : PrimaryExprEmptySequence ∙
 
 			if p.follow(symbols.NT_PrimaryExpr) {
 				p.rtn(symbols.NT_PrimaryExpr, cU, p.cI)
 			} else {
 				p.parseError(slot.PrimaryExpr1R0, p.cI, followSets[symbols.NT_PrimaryExpr])
 			}
-		case slot.PrimaryExpr2R0: // PrimaryExpr : ∙Number
+		case slot.PrimaryExpr2R0: // PrimaryExpr : ∙Literal
 
 			p.call(slot.PrimaryExpr2R1, cU, p.cI)
-		case slot.PrimaryExpr2R1: // PrimaryExpr : Number ∙
+		case slot.PrimaryExpr2R1: // PrimaryExpr : Literal ∙
 
 			if p.follow(symbols.NT_PrimaryExpr) {
 				p.rtn(symbols.NT_PrimaryExpr, cU, p.cI)
 			} else {
 				p.parseError(slot.PrimaryExpr2R0, p.cI, followSets[symbols.NT_PrimaryExpr])
 			}
-		case slot.PrimaryExpr3R0: // PrimaryExpr : ∙VariableReference
+		case slot.PrimaryExpr3R0: // PrimaryExpr : ∙Number
 
 			p.call(slot.PrimaryExpr3R1, cU, p.cI)
-		case slot.PrimaryExpr3R1: // PrimaryExpr : VariableReference ∙
+		case slot.PrimaryExpr3R1: // PrimaryExpr : Number ∙
 
 			if p.follow(symbols.NT_PrimaryExpr) {
 				p.rtn(symbols.NT_PrimaryExpr, cU, p.cI)
 			} else {
 				p.parseError(slot.PrimaryExpr3R0, p.cI, followSets[symbols.NT_PrimaryExpr])
 			}
-		case slot.PrimaryExpr4R0: // PrimaryExpr : ∙FunctionCall
+		case slot.PrimaryExpr4R0: // PrimaryExpr : ∙VariableReference
 
 			p.call(slot.PrimaryExpr4R1, cU, p.cI)
-		case slot.PrimaryExpr4R1: // PrimaryExpr : FunctionCall ∙
+		case slot.PrimaryExpr4R1: // PrimaryExpr : VariableReference ∙
 
 			if p.follow(symbols.NT_PrimaryExpr) {
 				p.rtn(symbols.NT_PrimaryExpr, cU, p.cI)
 			} else {
 				p.parseError(slot.PrimaryExpr4R0, p.cI, followSets[symbols.NT_PrimaryExpr])
 			}
-		case slot.PrimaryExprParenthetic0R0: // PrimaryExprParenthetic : ∙( OrExpr )
+		case slot.PrimaryExpr5R0: // PrimaryExpr : ∙FunctionCall
+
+			p.call(slot.PrimaryExpr5R1, cU, p.cI)
+		case slot.PrimaryExpr5R1: // PrimaryExpr : FunctionCall ∙
+
+			if p.follow(symbols.NT_PrimaryExpr) {
+				p.rtn(symbols.NT_PrimaryExpr, cU, p.cI)
+			} else {
+				p.parseError(slot.PrimaryExpr5R0, p.cI, followSets[symbols.NT_PrimaryExpr])
+			}
+		case slot.PrimaryExprEmptySequence0R0: // PrimaryExprEmptySequence : ∙( )
+
+			p.bsrSet.Add(slot.PrimaryExprEmptySequence0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.PrimaryExprEmptySequence0R1) {
+				p.parseError(slot.PrimaryExprEmptySequence0R1, p.cI, first[slot.PrimaryExprEmptySequence0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.PrimaryExprEmptySequence0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_PrimaryExprEmptySequence) {
+				p.rtn(symbols.NT_PrimaryExprEmptySequence, cU, p.cI)
+			} else {
+				p.parseError(slot.PrimaryExprEmptySequence0R0, p.cI, followSets[symbols.NT_PrimaryExprEmptySequence])
+			}
+		case slot.PrimaryExprParenthetic0R0: // PrimaryExprParenthetic : ∙( Expr )
 
 			p.bsrSet.Add(slot.PrimaryExprParenthetic0R1, cU, p.cI, p.cI+1)
 			p.cI++
@@ -1600,7 +1925,7 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			}
 
 			p.call(slot.PrimaryExprParenthetic0R2, cU, p.cI)
-		case slot.PrimaryExprParenthetic0R2: // PrimaryExprParenthetic : ( OrExpr ∙)
+		case slot.PrimaryExprParenthetic0R2: // PrimaryExprParenthetic : ( Expr ∙)
 
 			if !p.testSelect(slot.PrimaryExprParenthetic0R2) {
 				p.parseError(slot.PrimaryExprParenthetic0R2, p.cI, first[slot.PrimaryExprParenthetic0R2])
@@ -1666,10 +1991,169 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.QNameNamespaceWithLocal0R0, p.cI, followSets[symbols.NT_QNameNamespaceWithLocal])
 			}
-		case slot.RelationalExpr0R0: // RelationalExpr : ∙AdditiveExpr
+		case slot.QuantifiedExpr0R0: // QuantifiedExpr : ∙QuantifiedExprSome
+
+			p.call(slot.QuantifiedExpr0R1, cU, p.cI)
+		case slot.QuantifiedExpr0R1: // QuantifiedExpr : QuantifiedExprSome ∙
+
+			if p.follow(symbols.NT_QuantifiedExpr) {
+				p.rtn(symbols.NT_QuantifiedExpr, cU, p.cI)
+			} else {
+				p.parseError(slot.QuantifiedExpr0R0, p.cI, followSets[symbols.NT_QuantifiedExpr])
+			}
+		case slot.QuantifiedExpr1R0: // QuantifiedExpr : ∙QuantifiedExprEvery
+
+			p.call(slot.QuantifiedExpr1R1, cU, p.cI)
+		case slot.QuantifiedExpr1R1: // QuantifiedExpr : QuantifiedExprEvery ∙
+
+			if p.follow(symbols.NT_QuantifiedExpr) {
+				p.rtn(symbols.NT_QuantifiedExpr, cU, p.cI)
+			} else {
+				p.parseError(slot.QuantifiedExpr1R0, p.cI, followSets[symbols.NT_QuantifiedExpr])
+			}
+		case slot.QuantifiedExprEvery0R0: // QuantifiedExprEvery : ∙every VariableReference in ExprSingle satisfies ExprSingle
+
+			p.bsrSet.Add(slot.QuantifiedExprEvery0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.QuantifiedExprEvery0R1) {
+				p.parseError(slot.QuantifiedExprEvery0R1, p.cI, first[slot.QuantifiedExprEvery0R1])
+				break
+			}
+
+			p.call(slot.QuantifiedExprEvery0R2, cU, p.cI)
+		case slot.QuantifiedExprEvery0R2: // QuantifiedExprEvery : every VariableReference ∙in ExprSingle satisfies ExprSingle
+
+			if !p.testSelect(slot.QuantifiedExprEvery0R2) {
+				p.parseError(slot.QuantifiedExprEvery0R2, p.cI, first[slot.QuantifiedExprEvery0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.QuantifiedExprEvery0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.QuantifiedExprEvery0R3) {
+				p.parseError(slot.QuantifiedExprEvery0R3, p.cI, first[slot.QuantifiedExprEvery0R3])
+				break
+			}
+
+			p.call(slot.QuantifiedExprEvery0R4, cU, p.cI)
+		case slot.QuantifiedExprEvery0R4: // QuantifiedExprEvery : every VariableReference in ExprSingle ∙satisfies ExprSingle
+
+			if !p.testSelect(slot.QuantifiedExprEvery0R4) {
+				p.parseError(slot.QuantifiedExprEvery0R4, p.cI, first[slot.QuantifiedExprEvery0R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.QuantifiedExprEvery0R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.QuantifiedExprEvery0R5) {
+				p.parseError(slot.QuantifiedExprEvery0R5, p.cI, first[slot.QuantifiedExprEvery0R5])
+				break
+			}
+
+			p.call(slot.QuantifiedExprEvery0R6, cU, p.cI)
+		case slot.QuantifiedExprEvery0R6: // QuantifiedExprEvery : every VariableReference in ExprSingle satisfies ExprSingle ∙
+
+			if p.follow(symbols.NT_QuantifiedExprEvery) {
+				p.rtn(symbols.NT_QuantifiedExprEvery, cU, p.cI)
+			} else {
+				p.parseError(slot.QuantifiedExprEvery0R0, p.cI, followSets[symbols.NT_QuantifiedExprEvery])
+			}
+		case slot.QuantifiedExprSome0R0: // QuantifiedExprSome : ∙some VariableReference in ExprSingle satisfies ExprSingle
+
+			p.bsrSet.Add(slot.QuantifiedExprSome0R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.QuantifiedExprSome0R1) {
+				p.parseError(slot.QuantifiedExprSome0R1, p.cI, first[slot.QuantifiedExprSome0R1])
+				break
+			}
+
+			p.call(slot.QuantifiedExprSome0R2, cU, p.cI)
+		case slot.QuantifiedExprSome0R2: // QuantifiedExprSome : some VariableReference ∙in ExprSingle satisfies ExprSingle
+
+			if !p.testSelect(slot.QuantifiedExprSome0R2) {
+				p.parseError(slot.QuantifiedExprSome0R2, p.cI, first[slot.QuantifiedExprSome0R2])
+				break
+			}
+
+			p.bsrSet.Add(slot.QuantifiedExprSome0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.QuantifiedExprSome0R3) {
+				p.parseError(slot.QuantifiedExprSome0R3, p.cI, first[slot.QuantifiedExprSome0R3])
+				break
+			}
+
+			p.call(slot.QuantifiedExprSome0R4, cU, p.cI)
+		case slot.QuantifiedExprSome0R4: // QuantifiedExprSome : some VariableReference in ExprSingle ∙satisfies ExprSingle
+
+			if !p.testSelect(slot.QuantifiedExprSome0R4) {
+				p.parseError(slot.QuantifiedExprSome0R4, p.cI, first[slot.QuantifiedExprSome0R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.QuantifiedExprSome0R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.QuantifiedExprSome0R5) {
+				p.parseError(slot.QuantifiedExprSome0R5, p.cI, first[slot.QuantifiedExprSome0R5])
+				break
+			}
+
+			p.call(slot.QuantifiedExprSome0R6, cU, p.cI)
+		case slot.QuantifiedExprSome0R6: // QuantifiedExprSome : some VariableReference in ExprSingle satisfies ExprSingle ∙
+
+			if p.follow(symbols.NT_QuantifiedExprSome) {
+				p.rtn(symbols.NT_QuantifiedExprSome, cU, p.cI)
+			} else {
+				p.parseError(slot.QuantifiedExprSome0R0, p.cI, followSets[symbols.NT_QuantifiedExprSome])
+			}
+		case slot.RangeExpr0R0: // RangeExpr : ∙AdditiveExpr
+
+			p.call(slot.RangeExpr0R1, cU, p.cI)
+		case slot.RangeExpr0R1: // RangeExpr : AdditiveExpr ∙
+
+			if p.follow(symbols.NT_RangeExpr) {
+				p.rtn(symbols.NT_RangeExpr, cU, p.cI)
+			} else {
+				p.parseError(slot.RangeExpr0R0, p.cI, followSets[symbols.NT_RangeExpr])
+			}
+		case slot.RangeExpr1R0: // RangeExpr : ∙RangeExprTo
+
+			p.call(slot.RangeExpr1R1, cU, p.cI)
+		case slot.RangeExpr1R1: // RangeExpr : RangeExprTo ∙
+
+			if p.follow(symbols.NT_RangeExpr) {
+				p.rtn(symbols.NT_RangeExpr, cU, p.cI)
+			} else {
+				p.parseError(slot.RangeExpr1R0, p.cI, followSets[symbols.NT_RangeExpr])
+			}
+		case slot.RangeExprTo0R0: // RangeExprTo : ∙AdditiveExpr to AdditiveExpr
+
+			p.call(slot.RangeExprTo0R1, cU, p.cI)
+		case slot.RangeExprTo0R1: // RangeExprTo : AdditiveExpr ∙to AdditiveExpr
+
+			if !p.testSelect(slot.RangeExprTo0R1) {
+				p.parseError(slot.RangeExprTo0R1, p.cI, first[slot.RangeExprTo0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.RangeExprTo0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.RangeExprTo0R2) {
+				p.parseError(slot.RangeExprTo0R2, p.cI, first[slot.RangeExprTo0R2])
+				break
+			}
+
+			p.call(slot.RangeExprTo0R3, cU, p.cI)
+		case slot.RangeExprTo0R3: // RangeExprTo : AdditiveExpr to AdditiveExpr ∙
+
+			if p.follow(symbols.NT_RangeExprTo) {
+				p.rtn(symbols.NT_RangeExprTo, cU, p.cI)
+			} else {
+				p.parseError(slot.RangeExprTo0R0, p.cI, followSets[symbols.NT_RangeExprTo])
+			}
+		case slot.RelationalExpr0R0: // RelationalExpr : ∙RangeExpr
 
 			p.call(slot.RelationalExpr0R1, cU, p.cI)
-		case slot.RelationalExpr0R1: // RelationalExpr : AdditiveExpr ∙
+		case slot.RelationalExpr0R1: // RelationalExpr : RangeExpr ∙
 
 			if p.follow(symbols.NT_RelationalExpr) {
 				p.rtn(symbols.NT_RelationalExpr, cU, p.cI)
@@ -1716,10 +2200,10 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.RelationalExpr4R0, p.cI, followSets[symbols.NT_RelationalExpr])
 			}
-		case slot.RelationalExprGreaterThan0R0: // RelationalExprGreaterThan : ∙RelationalExpr > AdditiveExpr
+		case slot.RelationalExprGreaterThan0R0: // RelationalExprGreaterThan : ∙RelationalExpr > RangeExpr
 
 			p.call(slot.RelationalExprGreaterThan0R1, cU, p.cI)
-		case slot.RelationalExprGreaterThan0R1: // RelationalExprGreaterThan : RelationalExpr ∙> AdditiveExpr
+		case slot.RelationalExprGreaterThan0R1: // RelationalExprGreaterThan : RelationalExpr ∙> RangeExpr
 
 			if !p.testSelect(slot.RelationalExprGreaterThan0R1) {
 				p.parseError(slot.RelationalExprGreaterThan0R1, p.cI, first[slot.RelationalExprGreaterThan0R1])
@@ -1734,17 +2218,17 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			}
 
 			p.call(slot.RelationalExprGreaterThan0R3, cU, p.cI)
-		case slot.RelationalExprGreaterThan0R3: // RelationalExprGreaterThan : RelationalExpr > AdditiveExpr ∙
+		case slot.RelationalExprGreaterThan0R3: // RelationalExprGreaterThan : RelationalExpr > RangeExpr ∙
 
 			if p.follow(symbols.NT_RelationalExprGreaterThan) {
 				p.rtn(symbols.NT_RelationalExprGreaterThan, cU, p.cI)
 			} else {
 				p.parseError(slot.RelationalExprGreaterThan0R0, p.cI, followSets[symbols.NT_RelationalExprGreaterThan])
 			}
-		case slot.RelationalExprGreaterThanOrEqual0R0: // RelationalExprGreaterThanOrEqual : ∙RelationalExpr >= AdditiveExpr
+		case slot.RelationalExprGreaterThanOrEqual0R0: // RelationalExprGreaterThanOrEqual : ∙RelationalExpr >= RangeExpr
 
 			p.call(slot.RelationalExprGreaterThanOrEqual0R1, cU, p.cI)
-		case slot.RelationalExprGreaterThanOrEqual0R1: // RelationalExprGreaterThanOrEqual : RelationalExpr ∙>= AdditiveExpr
+		case slot.RelationalExprGreaterThanOrEqual0R1: // RelationalExprGreaterThanOrEqual : RelationalExpr ∙>= RangeExpr
 
 			if !p.testSelect(slot.RelationalExprGreaterThanOrEqual0R1) {
 				p.parseError(slot.RelationalExprGreaterThanOrEqual0R1, p.cI, first[slot.RelationalExprGreaterThanOrEqual0R1])
@@ -1759,17 +2243,17 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			}
 
 			p.call(slot.RelationalExprGreaterThanOrEqual0R3, cU, p.cI)
-		case slot.RelationalExprGreaterThanOrEqual0R3: // RelationalExprGreaterThanOrEqual : RelationalExpr >= AdditiveExpr ∙
+		case slot.RelationalExprGreaterThanOrEqual0R3: // RelationalExprGreaterThanOrEqual : RelationalExpr >= RangeExpr ∙
 
 			if p.follow(symbols.NT_RelationalExprGreaterThanOrEqual) {
 				p.rtn(symbols.NT_RelationalExprGreaterThanOrEqual, cU, p.cI)
 			} else {
 				p.parseError(slot.RelationalExprGreaterThanOrEqual0R0, p.cI, followSets[symbols.NT_RelationalExprGreaterThanOrEqual])
 			}
-		case slot.RelationalExprLessThan0R0: // RelationalExprLessThan : ∙RelationalExpr < AdditiveExpr
+		case slot.RelationalExprLessThan0R0: // RelationalExprLessThan : ∙RelationalExpr < RangeExpr
 
 			p.call(slot.RelationalExprLessThan0R1, cU, p.cI)
-		case slot.RelationalExprLessThan0R1: // RelationalExprLessThan : RelationalExpr ∙< AdditiveExpr
+		case slot.RelationalExprLessThan0R1: // RelationalExprLessThan : RelationalExpr ∙< RangeExpr
 
 			if !p.testSelect(slot.RelationalExprLessThan0R1) {
 				p.parseError(slot.RelationalExprLessThan0R1, p.cI, first[slot.RelationalExprLessThan0R1])
@@ -1784,17 +2268,17 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			}
 
 			p.call(slot.RelationalExprLessThan0R3, cU, p.cI)
-		case slot.RelationalExprLessThan0R3: // RelationalExprLessThan : RelationalExpr < AdditiveExpr ∙
+		case slot.RelationalExprLessThan0R3: // RelationalExprLessThan : RelationalExpr < RangeExpr ∙
 
 			if p.follow(symbols.NT_RelationalExprLessThan) {
 				p.rtn(symbols.NT_RelationalExprLessThan, cU, p.cI)
 			} else {
 				p.parseError(slot.RelationalExprLessThan0R0, p.cI, followSets[symbols.NT_RelationalExprLessThan])
 			}
-		case slot.RelationalExprLessThanOrEqual0R0: // RelationalExprLessThanOrEqual : ∙RelationalExpr <= AdditiveExpr
+		case slot.RelationalExprLessThanOrEqual0R0: // RelationalExprLessThanOrEqual : ∙RelationalExpr <= RangeExpr
 
 			p.call(slot.RelationalExprLessThanOrEqual0R1, cU, p.cI)
-		case slot.RelationalExprLessThanOrEqual0R1: // RelationalExprLessThanOrEqual : RelationalExpr ∙<= AdditiveExpr
+		case slot.RelationalExprLessThanOrEqual0R1: // RelationalExprLessThanOrEqual : RelationalExpr ∙<= RangeExpr
 
 			if !p.testSelect(slot.RelationalExprLessThanOrEqual0R1) {
 				p.parseError(slot.RelationalExprLessThanOrEqual0R1, p.cI, first[slot.RelationalExprLessThanOrEqual0R1])
@@ -1809,7 +2293,7 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			}
 
 			p.call(slot.RelationalExprLessThanOrEqual0R3, cU, p.cI)
-		case slot.RelationalExprLessThanOrEqual0R3: // RelationalExprLessThanOrEqual : RelationalExpr <= AdditiveExpr ∙
+		case slot.RelationalExprLessThanOrEqual0R3: // RelationalExprLessThanOrEqual : RelationalExpr <= RangeExpr ∙
 
 			if p.follow(symbols.NT_RelationalExprLessThanOrEqual) {
 				p.rtn(symbols.NT_RelationalExprLessThanOrEqual, cU, p.cI)
@@ -1846,27 +2330,76 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.RelativeLocationPath2R0, p.cI, followSets[symbols.NT_RelativeLocationPath])
 			}
-		case slot.RelativeLocationPathWithStep0R0: // RelativeLocationPathWithStep : ∙RelativeLocationPath / Step
+		case slot.RelativeLocationPath3R0: // RelativeLocationPath : ∙RelativeLocationPathWithExprStep
 
-			p.call(slot.RelativeLocationPathWithStep0R1, cU, p.cI)
-		case slot.RelativeLocationPathWithStep0R1: // RelativeLocationPathWithStep : RelativeLocationPath ∙/ Step
+			p.call(slot.RelativeLocationPath3R1, cU, p.cI)
+		case slot.RelativeLocationPath3R1: // RelativeLocationPath : RelativeLocationPathWithExprStep ∙
 
-			if !p.testSelect(slot.RelativeLocationPathWithStep0R1) {
-				p.parseError(slot.RelativeLocationPathWithStep0R1, p.cI, first[slot.RelativeLocationPathWithStep0R1])
+			if p.follow(symbols.NT_RelativeLocationPath) {
+				p.rtn(symbols.NT_RelativeLocationPath, cU, p.cI)
+			} else {
+				p.parseError(slot.RelativeLocationPath3R0, p.cI, followSets[symbols.NT_RelativeLocationPath])
+			}
+		case slot.RelativeLocationPathWithExprStep0R0: // RelativeLocationPathWithExprStep : ∙RelativeLocationPath / ( Expr )
+
+			p.call(slot.RelativeLocationPathWithExprStep0R1, cU, p.cI)
+		case slot.RelativeLocationPathWithExprStep0R1: // RelativeLocationPathWithExprStep : RelativeLocationPath ∙/ ( Expr )
+
+			if !p.testSelect(slot.RelativeLocationPathWithExprStep0R1) {
+				p.parseError(slot.RelativeLocationPathWithExprStep0R1, p.cI, first[slot.RelativeLocationPathWithExprStep0R1])
 				break
 			}
 
-			p.bsrSet.Add(slot.RelativeLocationPathWithStep0R2, cU, p.cI, p.cI+1)
+			p.bsrSet.Add(slot.RelativeLocationPathWithExprStep0R2, cU, p.cI, p.cI+1)
 			p.cI++
-			if !p.testSelect(slot.RelativeLocationPathWithStep0R2) {
-				p.parseError(slot.RelativeLocationPathWithStep0R2, p.cI, first[slot.RelativeLocationPathWithStep0R2])
+			if !p.testSelect(slot.RelativeLocationPathWithExprStep0R2) {
+				p.parseError(slot.RelativeLocationPathWithExprStep0R2, p.cI, first[slot.RelativeLocationPathWithExprStep0R2])
 				break
 			}
 
-			p.call(slot.RelativeLocationPathWithStep0R3, cU, p.cI)
-		case slot.RelativeLocationPathWithStep0R3: // RelativeLocationPathWithStep : RelativeLocationPath / Step ∙
+			p.bsrSet.Add(slot.RelativeLocationPathWithExprStep0R3, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.RelativeLocationPathWithExprStep0R3) {
+				p.parseError(slot.RelativeLocationPathWithExprStep0R3, p.cI, first[slot.RelativeLocationPathWithExprStep0R3])
+				break
+			}
 
-			if p.follow(symbols.NT_RelativeLocationPathWithStep) {
+			p.call(slot.RelativeLocationPathWithExprStep0R4, cU, p.cI)
+		case slot.RelativeLocationPathWithExprStep0R4: // RelativeLocationPathWithExprStep : RelativeLocationPath / ( Expr ∙)
+
+			if !p.testSelect(slot.RelativeLocationPathWithExprStep0R4) {
+				p.parseError(slot.RelativeLocationPathWithExprStep0R4, p.cI, first[slot.RelativeLocationPathWithExprStep0R4])
+				break
+			}
+
+			p.bsrSet.Add(slot.RelativeLocationPathWithExprStep0R5, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_RelativeLocationPathWithExprStep) {
+				p.rtn(symbols.NT_RelativeLocationPathWithExprStep, cU, p.cI)
+			} else {
+				p.parseError(slot.RelativeLocationPathWithExprStep0R0, p.cI, followSets[symbols.NT_RelativeLocationPathWithExprStep])
+			}
+		case slot.RelativeLocationPathWithStep0R0: // RelativeLocationPathWithStep : ∙RelativeLocationPath / Step
+
+			p.call(slot.RelativeLocationPathWithStep0R1, cU, p.cI)
+		case slot.RelativeLocationPathWithStep0R1: // RelativeLocationPathWithStep : RelativeLocationPath ∙/ Step
+
+			if !p.testSelect(slot.RelativeLocationPathWithStep0R1) {
+				p.parseError(slot.RelativeLocationPathWithStep0R1, p.cI, first[slot.RelativeLocationPathWithStep0R1])
+				break
+			}
+
+			p.bsrSet.Add(slot.RelativeLocationPathWithStep0R2, cU, p.cI, p.cI+1)
+			p.cI++
+			if !p.testSelect(slot.RelativeLocationPathWithStep0R2) {
+				p.parseError(slot.RelativeLocationPathWithStep0R2, p.cI, first[slot.RelativeLocationPathWithStep0R2])
+				break
+			}
+
+			p.call(slot.RelativeLocationPathWithStep0R3, cU, p.cI)
+		case slot.RelativeLocationPathWithStep0R3: // RelativeLocationPathWithStep : RelativeLocationPath / Step ∙
+
+			if p.follow(symbols.NT_RelativeLocationPathWithStep) {
 				p.rtn(symbols.NT_RelativeLocationPathWithStep, cU, p.cI)
 			} else {
 				p.parseError(slot.RelativeLocationPathWithStep0R0, p.cI, followSets[symbols.NT_RelativeLocationPathWithStep])
@@ -2024,6 +2557,105 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			} else {
 				p.parseError(slot.ReservedNameConflictResolver16R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
 			}
+		case slot.ReservedNameConflictResolver17R0: // ReservedNameConflictResolver : ∙if
+
+			p.bsrSet.Add(slot.ReservedNameConflictResolver17R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_ReservedNameConflictResolver) {
+				p.rtn(symbols.NT_ReservedNameConflictResolver, cU, p.cI)
+			} else {
+				p.parseError(slot.ReservedNameConflictResolver17R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
+			}
+		case slot.ReservedNameConflictResolver18R0: // ReservedNameConflictResolver : ∙then
+
+			p.bsrSet.Add(slot.ReservedNameConflictResolver18R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_ReservedNameConflictResolver) {
+				p.rtn(symbols.NT_ReservedNameConflictResolver, cU, p.cI)
+			} else {
+				p.parseError(slot.ReservedNameConflictResolver18R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
+			}
+		case slot.ReservedNameConflictResolver19R0: // ReservedNameConflictResolver : ∙else
+
+			p.bsrSet.Add(slot.ReservedNameConflictResolver19R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_ReservedNameConflictResolver) {
+				p.rtn(symbols.NT_ReservedNameConflictResolver, cU, p.cI)
+			} else {
+				p.parseError(slot.ReservedNameConflictResolver19R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
+			}
+		case slot.ReservedNameConflictResolver20R0: // ReservedNameConflictResolver : ∙let
+
+			p.bsrSet.Add(slot.ReservedNameConflictResolver20R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_ReservedNameConflictResolver) {
+				p.rtn(symbols.NT_ReservedNameConflictResolver, cU, p.cI)
+			} else {
+				p.parseError(slot.ReservedNameConflictResolver20R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
+			}
+		case slot.ReservedNameConflictResolver21R0: // ReservedNameConflictResolver : ∙for
+
+			p.bsrSet.Add(slot.ReservedNameConflictResolver21R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_ReservedNameConflictResolver) {
+				p.rtn(symbols.NT_ReservedNameConflictResolver, cU, p.cI)
+			} else {
+				p.parseError(slot.ReservedNameConflictResolver21R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
+			}
+		case slot.ReservedNameConflictResolver22R0: // ReservedNameConflictResolver : ∙in
+
+			p.bsrSet.Add(slot.ReservedNameConflictResolver22R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_ReservedNameConflictResolver) {
+				p.rtn(symbols.NT_ReservedNameConflictResolver, cU, p.cI)
+			} else {
+				p.parseError(slot.ReservedNameConflictResolver22R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
+			}
+		case slot.ReservedNameConflictResolver23R0: // ReservedNameConflictResolver : ∙return
+
+			p.bsrSet.Add(slot.ReservedNameConflictResolver23R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_ReservedNameConflictResolver) {
+				p.rtn(symbols.NT_ReservedNameConflictResolver, cU, p.cI)
+			} else {
+				p.parseError(slot.ReservedNameConflictResolver23R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
+			}
+		case slot.ReservedNameConflictResolver24R0: // ReservedNameConflictResolver : ∙some
+
+			p.bsrSet.Add(slot.ReservedNameConflictResolver24R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_ReservedNameConflictResolver) {
+				p.rtn(symbols.NT_ReservedNameConflictResolver, cU, p.cI)
+			} else {
+				p.parseError(slot.ReservedNameConflictResolver24R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
+			}
+		case slot.ReservedNameConflictResolver25R0: // ReservedNameConflictResolver : ∙every
+
+			p.bsrSet.Add(slot.ReservedNameConflictResolver25R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_ReservedNameConflictResolver) {
+				p.rtn(symbols.NT_ReservedNameConflictResolver, cU, p.cI)
+			} else {
+				p.parseError(slot.ReservedNameConflictResolver25R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
+			}
+		case slot.ReservedNameConflictResolver26R0: // ReservedNameConflictResolver : ∙satisfies
+
+			p.bsrSet.Add(slot.ReservedNameConflictResolver26R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_ReservedNameConflictResolver) {
+				p.rtn(symbols.NT_ReservedNameConflictResolver, cU, p.cI)
+			} else {
+				p.parseError(slot.ReservedNameConflictResolver26R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
+			}
+		case slot.ReservedNameConflictResolver27R0: // ReservedNameConflictResolver : ∙to
+
+			p.bsrSet.Add(slot.ReservedNameConflictResolver27R1, cU, p.cI, p.cI+1)
+			p.cI++
+			if p.follow(symbols.NT_ReservedNameConflictResolver) {
+				p.rtn(symbols.NT_ReservedNameConflictResolver, cU, p.cI)
+			} else {
+				p.parseError(slot.ReservedNameConflictResolver27R0, p.cI, followSets[symbols.NT_ReservedNameConflictResolver])
+			}
 		case slot.Step0R0: // Step : ∙NodeTestAndPredicate
 
 			p.call(slot.Step0R1, cU, p.cI)
@@ -2254,7 +2886,7 @@ func (p *parser) parse() (*bsr.Set, []*Error) {
 			panic("This must not happen")
 		}
 	}
-	if !p.bsrSet.Contain(symbols.NT_OrExpr, 0, m) {
+	if !p.bsrSet.Contain(symbols.NT_Expr, 0, m) {
 		p.sortParseErrors()
 		return nil, p.parseErrors
 	}
@@ -2504,25 +3136,36 @@ var first = []map[token.Type]string{
 		token.T_3:  "*",
 		token.T_7:  ".",
 		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// AbbreviatedAbsoluteLocationPath : // RelativeLocationPath ∙
 	{
@@ -2533,68 +3176,94 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbbreviatedAxisSpecifier : ∙@
 	{
-		token.T_18: "@",
+		token.T_19: "@",
 	},
 	// AbbreviatedAxisSpecifier : @ ∙
 	{
 		token.T_3:  "*",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// AbbreviatedRelativeLocationPath : ∙RelativeLocationPath // Step
 	{
 		token.T_3:  "*",
 		token.T_7:  ".",
 		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// AbbreviatedRelativeLocationPath : RelativeLocationPath ∙// Step
 	{
@@ -2605,25 +3274,36 @@ var first = []map[token.Type]string{
 		token.T_3:  "*",
 		token.T_7:  ".",
 		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// AbbreviatedRelativeLocationPath : RelativeLocationPath // Step ∙
 	{
@@ -2636,17 +3316,21 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbbreviatedStep : ∙AbbreviatedStepSelf
 	{
@@ -2663,17 +3347,21 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbbreviatedStep : ∙AbbreviatedStepParent
 	{
@@ -2690,17 +3378,21 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbbreviatedStepParent : ∙..
 	{
@@ -2717,17 +3409,21 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbbreviatedStepSelf : ∙.
 	{
@@ -2744,17 +3440,21 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbsoluteLocationPath : ∙AbsoluteLocationPathOnly
 	{
@@ -2769,17 +3469,21 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbsoluteLocationPath : ∙AbsoluteLocationPathWithRelative
 	{
@@ -2794,17 +3498,21 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbsoluteLocationPath : ∙AbbreviatedAbsoluteLocationPath
 	{
@@ -2819,17 +3527,21 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbsoluteLocationPathOnly : ∙/
 	{
@@ -2844,17 +3556,21 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbsoluteLocationPathWithRelative : ∙/ RelativeLocationPath
 	{
@@ -2865,25 +3581,36 @@ var first = []map[token.Type]string{
 		token.T_3:  "*",
 		token.T_7:  ".",
 		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// AbsoluteLocationPathWithRelative : / RelativeLocationPath ∙
 	{
@@ -2894,17 +3621,21 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AdditiveExpr : ∙MultiplicativeExpr
 	{
@@ -2915,29 +3646,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// AdditiveExpr : MultiplicativeExpr ∙
 	{
@@ -2947,14 +3689,18 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// AdditiveExpr : ∙AdditiveExprAdd
 	{
@@ -2965,29 +3711,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// AdditiveExpr : AdditiveExprAdd ∙
 	{
@@ -2997,14 +3754,18 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// AdditiveExpr : ∙AdditiveExprSubtract
 	{
@@ -3015,29 +3776,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// AdditiveExpr : AdditiveExprSubtract ∙
 	{
@@ -3047,14 +3819,18 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// AdditiveExprAdd : ∙AdditiveExpr + MultiplicativeExpr
 	{
@@ -3065,29 +3841,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// AdditiveExprAdd : AdditiveExpr ∙+ MultiplicativeExpr
 	{
@@ -3102,29 +3889,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// AdditiveExprAdd : AdditiveExpr + MultiplicativeExpr ∙
 	{
@@ -3134,14 +3932,18 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// AdditiveExprSubtract : ∙AdditiveExpr - MultiplicativeExpr
 	{
@@ -3152,29 +3954,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// AdditiveExprSubtract : AdditiveExpr ∙- MultiplicativeExpr
 	{
@@ -3189,29 +4002,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// AdditiveExprSubtract : AdditiveExpr - MultiplicativeExpr ∙
 	{
@@ -3221,14 +4045,18 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// AndExpr : ∙EqualityExpr
 	{
@@ -3239,38 +4067,52 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// AndExpr : EqualityExpr ∙
 	{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// AndExpr : ∙AndExprAnd
 	{
@@ -3281,38 +4123,52 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// AndExpr : AndExprAnd ∙
 	{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// AndExprAnd : ∙AndExpr and EqualityExpr
 	{
@@ -3323,33 +4179,44 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// AndExprAnd : AndExpr ∙and EqualityExpr
 	{
-		token.T_23: "and",
+		token.T_24: "and",
 	},
 	// AndExprAnd : AndExpr and ∙EqualityExpr
 	{
@@ -3360,42 +4227,56 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// AndExprAnd : AndExpr and EqualityExpr ∙
 	{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// AxisName : ∙ancestor
 	{
-		token.T_21: "ancestor",
+		token.T_22: "ancestor",
 	},
 	// AxisName : ancestor ∙
 	{
@@ -3403,7 +4284,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙ancestor-or-self
 	{
-		token.T_22: "ancestor-or-self",
+		token.T_23: "ancestor-or-self",
 	},
 	// AxisName : ancestor-or-self ∙
 	{
@@ -3411,7 +4292,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙attribute
 	{
-		token.T_24: "attribute",
+		token.T_25: "attribute",
 	},
 	// AxisName : attribute ∙
 	{
@@ -3419,7 +4300,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙child
 	{
-		token.T_25: "child",
+		token.T_26: "child",
 	},
 	// AxisName : child ∙
 	{
@@ -3427,7 +4308,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙descendant
 	{
-		token.T_27: "descendant",
+		token.T_28: "descendant",
 	},
 	// AxisName : descendant ∙
 	{
@@ -3435,7 +4316,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙descendant-or-self
 	{
-		token.T_28: "descendant-or-self",
+		token.T_29: "descendant-or-self",
 	},
 	// AxisName : descendant-or-self ∙
 	{
@@ -3443,7 +4324,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙following
 	{
-		token.T_32: "following",
+		token.T_35: "following",
 	},
 	// AxisName : following ∙
 	{
@@ -3451,7 +4332,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙following-sibling
 	{
-		token.T_33: "following-sibling",
+		token.T_36: "following-sibling",
 	},
 	// AxisName : following-sibling ∙
 	{
@@ -3459,7 +4340,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙namespace
 	{
-		token.T_35: "namespace",
+		token.T_42: "namespace",
 	},
 	// AxisName : namespace ∙
 	{
@@ -3467,7 +4348,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙parent
 	{
-		token.T_39: "parent",
+		token.T_46: "parent",
 	},
 	// AxisName : parent ∙
 	{
@@ -3475,7 +4356,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙preceding
 	{
-		token.T_40: "preceding",
+		token.T_47: "preceding",
 	},
 	// AxisName : preceding ∙
 	{
@@ -3483,7 +4364,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙preceding-sibling
 	{
-		token.T_41: "preceding-sibling",
+		token.T_48: "preceding-sibling",
 	},
 	// AxisName : preceding-sibling ∙
 	{
@@ -3491,7 +4372,7 @@ var first = []map[token.Type]string{
 	},
 	// AxisName : ∙self
 	{
-		token.T_43: "self",
+		token.T_52: "self",
 	},
 	// AxisName : self ∙
 	{
@@ -3499,83 +4380,105 @@ var first = []map[token.Type]string{
 	},
 	// AxisSpecifier : ∙AxisSpecifierWithAxisName
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_43: "self",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_42: "namespace",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_52: "self",
 	},
 	// AxisSpecifier : AxisSpecifierWithAxisName ∙
 	{
 		token.T_3:  "*",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// AxisSpecifier : ∙AbbreviatedAxisSpecifier
 	{
-		token.T_18: "@",
+		token.T_19: "@",
 	},
 	// AxisSpecifier : AbbreviatedAxisSpecifier ∙
 	{
 		token.T_3:  "*",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// AxisSpecifierWithAxisName : ∙AxisName ::
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_43: "self",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_42: "namespace",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_52: "self",
 	},
 	// AxisSpecifierWithAxisName : AxisName ∙::
 	{
@@ -3584,24 +4487,35 @@ var first = []map[token.Type]string{
 	// AxisSpecifierWithAxisName : AxisName :: ∙
 	{
 		token.T_3:  "*",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// EqualityExpr : ∙RelationalExpr
 	{
@@ -3612,29 +4526,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// EqualityExpr : RelationalExpr ∙
 	{
@@ -3642,10 +4567,13 @@ var first = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_15: "=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_16: "=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// EqualityExpr : ∙EqualityExprEqual
 	{
@@ -3656,29 +4584,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// EqualityExpr : EqualityExprEqual ∙
 	{
@@ -3686,10 +4625,13 @@ var first = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_15: "=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_16: "=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// EqualityExpr : ∙EqualityExprNotEqual
 	{
@@ -3700,29 +4642,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// EqualityExpr : EqualityExprNotEqual ∙
 	{
@@ -3730,10 +4683,13 @@ var first = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_15: "=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_16: "=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// EqualityExprEqual : ∙EqualityExpr = RelationalExpr
 	{
@@ -3744,33 +4700,44 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// EqualityExprEqual : EqualityExpr ∙= RelationalExpr
 	{
-		token.T_15: "=",
+		token.T_16: "=",
 	},
 	// EqualityExprEqual : EqualityExpr = ∙RelationalExpr
 	{
@@ -3781,29 +4748,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// EqualityExprEqual : EqualityExpr = RelationalExpr ∙
 	{
@@ -3811,10 +4789,13 @@ var first = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_15: "=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_16: "=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// EqualityExprNotEqual : ∙EqualityExpr != RelationalExpr
 	{
@@ -3825,29 +4806,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// EqualityExprNotEqual : EqualityExpr ∙!= RelationalExpr
 	{
@@ -3862,29 +4854,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// EqualityExprNotEqual : EqualityExpr != RelationalExpr ∙
 	{
@@ -3892,20 +4895,332 @@ var first = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_15: "=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_16: "=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// Expr : ∙ExprSingle
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// Expr : ExprSingle ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_21: "]",
+	},
+	// Expr : ∙ExprComma
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// Expr : ExprComma ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_21: "]",
+	},
+	// ExprComma : ∙ExprSingle , Expr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// ExprComma : ExprSingle ∙, Expr
+	{
+		token.T_5: ",",
+	},
+	// ExprComma : ExprSingle , ∙Expr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// ExprComma : ExprSingle , Expr ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_21: "]",
+	},
+	// ExprSingle : ∙OrExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// ExprSingle : OrExpr ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// ExprSingle : ∙IfExpr
+	{
+		token.T_38: "if",
+	},
+	// ExprSingle : IfExpr ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// ExprSingle : ∙LetExpr
+	{
+		token.T_40: "let",
+	},
+	// ExprSingle : LetExpr ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// ExprSingle : ∙ForExpr
+	{
+		token.T_37: "for",
+	},
+	// ExprSingle : ForExpr ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// ExprSingle : ∙QuantifiedExpr
+	{
+		token.T_34: "every",
+		token.T_54: "some",
+	},
+	// ExprSingle : QuantifiedExpr ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// FilterExpr : ∙PrimaryExpr
 	{
 		token.T_1:  "(",
 		token.T_7:  ".",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_36: "ncname",
-		token.T_44: "singlequote",
-		token.T_46: "variableReference",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_43: "ncname",
+		token.T_53: "singlequote",
+		token.T_58: "variableReference",
 	},
 	// FilterExpr : PrimaryExpr ∙
 	{
@@ -3918,28 +5233,32 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FilterExpr : ∙FilterExprWithPredicate
 	{
 		token.T_1:  "(",
 		token.T_7:  ".",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_36: "ncname",
-		token.T_44: "singlequote",
-		token.T_46: "variableReference",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_43: "ncname",
+		token.T_53: "singlequote",
+		token.T_58: "variableReference",
 	},
 	// FilterExpr : FilterExprWithPredicate ∙
 	{
@@ -3952,32 +5271,36 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FilterExprWithPredicate : ∙FilterExpr Predicate
 	{
 		token.T_1:  "(",
 		token.T_7:  ".",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_36: "ncname",
-		token.T_44: "singlequote",
-		token.T_46: "variableReference",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_43: "ncname",
+		token.T_53: "singlequote",
+		token.T_58: "variableReference",
 	},
 	// FilterExprWithPredicate : FilterExpr ∙Predicate
 	{
-		token.T_19: "[",
+		token.T_20: "[",
 	},
 	// FilterExprWithPredicate : FilterExpr Predicate ∙
 	{
@@ -3990,22 +5313,140 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// ForExpr : ∙for VariableReference in ExprSingle return ExprSingle
+	{
+		token.T_37: "for",
+	},
+	// ForExpr : for ∙VariableReference in ExprSingle return ExprSingle
+	{
+		token.T_58: "variableReference",
+	},
+	// ForExpr : for VariableReference ∙in ExprSingle return ExprSingle
+	{
+		token.T_39: "in",
+	},
+	// ForExpr : for VariableReference in ∙ExprSingle return ExprSingle
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// ForExpr : for VariableReference in ExprSingle ∙return ExprSingle
+	{
+		token.T_50: "return",
+	},
+	// ForExpr : for VariableReference in ExprSingle return ∙ExprSingle
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// ForExpr : for VariableReference in ExprSingle return ExprSingle ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// FunctionCall : ∙QName ( FunctionSignature
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// FunctionCall : QName ∙( FunctionSignature
 	{
@@ -4021,29 +5462,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// FunctionCall : QName ( FunctionSignature ∙
 	{
@@ -4056,18 +5508,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FunctionCallArgumentList : ∙FunctionCallArgumentListArgWithNext
 	{
@@ -4078,29 +5534,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// FunctionCallArgumentList : FunctionCallArgumentListArgWithNext ∙
 	{
@@ -4113,18 +5580,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FunctionCallArgumentList : ∙FunctionCallArgumentListEndArg
 	{
@@ -4135,29 +5606,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// FunctionCallArgumentList : FunctionCallArgumentListEndArg ∙
 	{
@@ -4170,20 +5652,24 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// FunctionCallArgumentListArgWithNext : ∙OrExpr , FunctionCallArgumentList
+	// FunctionCallArgumentListArgWithNext : ∙ExprSingle , FunctionCallArgumentList
 	{
 		token.T_1:  "(",
 		token.T_3:  "*",
@@ -4192,35 +5678,46 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
-	},
-	// FunctionCallArgumentListArgWithNext : OrExpr ∙, FunctionCallArgumentList
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// FunctionCallArgumentListArgWithNext : ExprSingle ∙, FunctionCallArgumentList
 	{
 		token.T_5: ",",
 	},
-	// FunctionCallArgumentListArgWithNext : OrExpr , ∙FunctionCallArgumentList
+	// FunctionCallArgumentListArgWithNext : ExprSingle , ∙FunctionCallArgumentList
 	{
 		token.T_1:  "(",
 		token.T_3:  "*",
@@ -4229,55 +5726,70 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
-	},
-	// FunctionCallArgumentListArgWithNext : OrExpr , FunctionCallArgumentList ∙
-	{
-		token.T_0:  "!=",
-		token.EOF:  "$",
-		token.T_2:  ")",
-		token.T_3:  "*",
-		token.T_4:  "+",
-		token.T_5:  ",",
-		token.T_6:  "-",
-		token.T_9:  "/",
-		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
-	},
-	// FunctionCallArgumentListEndArg : ∙OrExpr )
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// FunctionCallArgumentListArgWithNext : ExprSingle , FunctionCallArgumentList ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// FunctionCallArgumentListEndArg : ∙ExprSingle )
 	{
 		token.T_1:  "(",
 		token.T_3:  "*",
@@ -4286,35 +5798,46 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
-	},
-	// FunctionCallArgumentListEndArg : OrExpr ∙)
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// FunctionCallArgumentListEndArg : ExprSingle ∙)
 	{
 		token.T_2: ")",
 	},
-	// FunctionCallArgumentListEndArg : OrExpr ) ∙
+	// FunctionCallArgumentListEndArg : ExprSingle ) ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -4325,18 +5848,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FunctionSignature : ∙FunctionSignatureNoArgs
 	{
@@ -4353,18 +5880,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FunctionSignature : ∙FunctionCallArgumentList
 	{
@@ -4375,29 +5906,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// FunctionSignature : FunctionCallArgumentList ∙
 	{
@@ -4410,18 +5952,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FunctionSignatureNoArgs : ∙)
 	{
@@ -4438,148 +5984,198 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// Literal : ∙singlequote
+	// IfExpr : ∙if ( Expr ) then ExprSingle else ExprSingle
 	{
-		token.T_44: "singlequote",
+		token.T_38: "if",
 	},
-	// Literal : singlequote ∙
+	// IfExpr : if ∙( Expr ) then ExprSingle else ExprSingle
 	{
-		token.T_0:  "!=",
-		token.EOF:  "$",
-		token.T_2:  ")",
+		token.T_1: "(",
+	},
+	// IfExpr : if ( ∙Expr ) then ExprSingle else ExprSingle
+	{
+		token.T_1:  "(",
 		token.T_3:  "*",
-		token.T_4:  "+",
-		token.T_5:  ",",
 		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// IfExpr : if ( Expr ∙) then ExprSingle else ExprSingle
+	{
+		token.T_2: ")",
 	},
-	// Literal : ∙doublequote
+	// IfExpr : if ( Expr ) ∙then ExprSingle else ExprSingle
 	{
-		token.T_31: "doublequote",
+		token.T_56: "then",
 	},
-	// Literal : doublequote ∙
+	// IfExpr : if ( Expr ) then ∙ExprSingle else ExprSingle
 	{
-		token.T_0:  "!=",
-		token.EOF:  "$",
-		token.T_2:  ")",
+		token.T_1:  "(",
 		token.T_3:  "*",
-		token.T_4:  "+",
-		token.T_5:  ",",
 		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
-	},
-	// LocationPath : ∙RelativeLocationPath
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// IfExpr : if ( Expr ) then ExprSingle ∙else ExprSingle
+	{
+		token.T_33: "else",
+	},
+	// IfExpr : if ( Expr ) then ExprSingle else ∙ExprSingle
 	{
+		token.T_1:  "(",
 		token.T_3:  "*",
+		token.T_6:  "-",
 		token.T_7:  ".",
 		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
-	// LocationPath : RelativeLocationPath ∙
+	// IfExpr : if ( Expr ) then ExprSingle else ExprSingle ∙
 	{
-		token.T_0:  "!=",
 		token.EOF:  "$",
 		token.T_2:  ")",
-		token.T_3:  "*",
-		token.T_4:  "+",
 		token.T_5:  ",",
-		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
-	// LocationPath : ∙AbsoluteLocationPath
+	// LetExpr : ∙let VariableReference := ExprSingle return ExprSingle
 	{
-		token.T_9:  "/",
-		token.T_10: "//",
+		token.T_40: "let",
 	},
-	// LocationPath : AbsoluteLocationPath ∙
+	// LetExpr : let ∙VariableReference := ExprSingle return ExprSingle
 	{
-		token.T_0:  "!=",
-		token.EOF:  "$",
-		token.T_2:  ")",
-		token.T_3:  "*",
-		token.T_4:  "+",
-		token.T_5:  ",",
-		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_58: "variableReference",
 	},
-	// MultiplicativeExpr : ∙UnaryExpr
+	// LetExpr : let VariableReference ∙:= ExprSingle return ExprSingle
+	{
+		token.T_13: ":=",
+	},
+	// LetExpr : let VariableReference := ∙ExprSingle return ExprSingle
 	{
 		token.T_1:  "(",
 		token.T_3:  "*",
@@ -4588,29 +6184,297 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// LetExpr : let VariableReference := ExprSingle ∙return ExprSingle
+	{
+		token.T_50: "return",
+	},
+	// LetExpr : let VariableReference := ExprSingle return ∙ExprSingle
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// LetExpr : let VariableReference := ExprSingle return ExprSingle ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// Literal : ∙singlequote
+	{
+		token.T_53: "singlequote",
+	},
+	// Literal : singlequote ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// Literal : ∙doublequote
+	{
+		token.T_32: "doublequote",
+	},
+	// Literal : doublequote ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// LocationPath : ∙RelativeLocationPath
+	{
+		token.T_3:  "*",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+	},
+	// LocationPath : RelativeLocationPath ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// LocationPath : ∙AbsoluteLocationPath
+	{
+		token.T_9:  "/",
+		token.T_10: "//",
+	},
+	// LocationPath : AbsoluteLocationPath ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// MultiplicativeExpr : ∙UnaryExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// MultiplicativeExpr : UnaryExpr ∙
 	{
@@ -4621,16 +6485,20 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// MultiplicativeExpr : ∙MultiplicativeExprMultiply
 	{
@@ -4641,29 +6509,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// MultiplicativeExpr : MultiplicativeExprMultiply ∙
 	{
@@ -4674,16 +6553,20 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// MultiplicativeExpr : ∙MultiplicativeExprDivide
 	{
@@ -4694,29 +6577,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// MultiplicativeExpr : MultiplicativeExprDivide ∙
 	{
@@ -4727,16 +6621,20 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// MultiplicativeExpr : ∙MultiplicativeExprMod
 	{
@@ -4747,29 +6645,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// MultiplicativeExpr : MultiplicativeExprMod ∙
 	{
@@ -4780,16 +6689,20 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// MultiplicativeExprDivide : ∙MultiplicativeExpr div UnaryExpr
 	{
@@ -4800,33 +6713,44 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// MultiplicativeExprDivide : MultiplicativeExpr ∙div UnaryExpr
 	{
-		token.T_30: "div",
+		token.T_31: "div",
 	},
 	// MultiplicativeExprDivide : MultiplicativeExpr div ∙UnaryExpr
 	{
@@ -4837,29 +6761,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// MultiplicativeExprDivide : MultiplicativeExpr div UnaryExpr ∙
 	{
@@ -4870,16 +6805,20 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// MultiplicativeExprMod : ∙MultiplicativeExpr mod UnaryExpr
 	{
@@ -4890,33 +6829,44 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// MultiplicativeExprMod : MultiplicativeExpr ∙mod UnaryExpr
 	{
-		token.T_34: "mod",
+		token.T_41: "mod",
 	},
 	// MultiplicativeExprMod : MultiplicativeExpr mod ∙UnaryExpr
 	{
@@ -4927,29 +6877,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// MultiplicativeExprMod : MultiplicativeExpr mod UnaryExpr ∙
 	{
@@ -4960,16 +6921,20 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// MultiplicativeExprMultiply : ∙MultiplicativeExpr * UnaryExpr
 	{
@@ -4980,29 +6945,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// MultiplicativeExprMultiply : MultiplicativeExpr ∙* UnaryExpr
 	{
@@ -5017,29 +6993,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// MultiplicativeExprMultiply : MultiplicativeExpr * UnaryExpr ∙
 	{
@@ -5050,16 +7037,20 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// NameTestAnyElement : ∙*
 	{
@@ -5076,18 +7067,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestLocalAnyNamespace : ∙* : ncname
 	{
@@ -5099,7 +7094,7 @@ var first = []map[token.Type]string{
 	},
 	// NameTestLocalAnyNamespace : * : ∙ncname
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// NameTestLocalAnyNamespace : * : ncname ∙
 	{
@@ -5112,18 +7107,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestLocalAnyNamespaceReservedNameConflict : ∙* : ReservedNameConflictResolver
 	{
@@ -5135,23 +7134,34 @@ var first = []map[token.Type]string{
 	},
 	// NameTestLocalAnyNamespaceReservedNameConflict : * : ∙ReservedNameConflictResolver
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NameTestLocalAnyNamespaceReservedNameConflict : * : ReservedNameConflictResolver ∙
 	{
@@ -5164,22 +7174,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestNamespaceAnyLocal : ∙ncname : *
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// NameTestNamespaceAnyLocal : ncname ∙: *
 	{
@@ -5200,38 +7214,53 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestNamespaceAnyLocalReservedNameConflict : ∙ReservedNameConflictResolver : *
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NameTestNamespaceAnyLocalReservedNameConflict : ReservedNameConflictResolver ∙: *
 	{
@@ -5252,22 +7281,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameLocalOnly : ∙ncname
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// NameTestQNameLocalOnly : ncname ∙
 	{
@@ -5280,38 +7313,53 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameLocalOnlyReservedNameConflict : ∙ReservedNameConflictResolver
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NameTestQNameLocalOnlyReservedNameConflict : ReservedNameConflictResolver ∙
 	{
@@ -5324,22 +7372,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameNamespaceWithLocal : ∙ncname : ncname
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// NameTestQNameNamespaceWithLocal : ncname ∙: ncname
 	{
@@ -5347,7 +7399,7 @@ var first = []map[token.Type]string{
 	},
 	// NameTestQNameNamespaceWithLocal : ncname : ∙ncname
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// NameTestQNameNamespaceWithLocal : ncname : ncname ∙
 	{
@@ -5360,38 +7412,53 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictBoth : ∙ReservedNameConflictResolver : ReservedNameConflictResolver
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictBoth : ReservedNameConflictResolver ∙: ReservedNameConflictResolver
 	{
@@ -5399,23 +7466,34 @@ var first = []map[token.Type]string{
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictBoth : ReservedNameConflictResolver : ∙ReservedNameConflictResolver
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictBoth : ReservedNameConflictResolver : ReservedNameConflictResolver ∙
 	{
@@ -5428,22 +7506,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictLocal : ∙ncname : ReservedNameConflictResolver
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictLocal : ncname ∙: ReservedNameConflictResolver
 	{
@@ -5451,23 +7533,34 @@ var first = []map[token.Type]string{
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictLocal : ncname : ∙ReservedNameConflictResolver
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictLocal : ncname : ReservedNameConflictResolver ∙
 	{
@@ -5480,38 +7573,53 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictNamespace : ∙ReservedNameConflictResolver : ncname
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictNamespace : ReservedNameConflictResolver ∙: ncname
 	{
@@ -5519,7 +7627,7 @@ var first = []map[token.Type]string{
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictNamespace : ReservedNameConflictResolver : ∙ncname
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictNamespace : ReservedNameConflictResolver : ncname ∙
 	{
@@ -5532,25 +7640,29 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NodeTestNodeTypeNoArgTest
 	{
-		token.T_26: "comment",
-		token.T_37: "node",
-		token.T_42: "processing-instruction",
-		token.T_45: "text",
+		token.T_27: "comment",
+		token.T_44: "node",
+		token.T_49: "processing-instruction",
+		token.T_55: "text",
 	},
 	// NodeTest : NodeTestNodeTypeNoArgTest ∙
 	{
@@ -5563,22 +7675,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NodeTestProcInstTargetTest
 	{
-		token.T_42: "processing-instruction",
+		token.T_49: "processing-instruction",
 	},
 	// NodeTest : NodeTestProcInstTargetTest ∙
 	{
@@ -5591,18 +7707,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NameTestAnyElement
 	{
@@ -5619,22 +7739,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NameTestNamespaceAnyLocal
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// NodeTest : NameTestNamespaceAnyLocal ∙
 	{
@@ -5647,38 +7771,53 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NameTestNamespaceAnyLocalReservedNameConflict
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NodeTest : NameTestNamespaceAnyLocalReservedNameConflict ∙
 	{
@@ -5691,18 +7830,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NameTestLocalAnyNamespace
 	{
@@ -5719,18 +7862,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NameTestLocalAnyNamespaceReservedNameConflict
 	{
@@ -5747,22 +7894,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NameTestQNameNamespaceWithLocal
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// NodeTest : NameTestQNameNamespaceWithLocal ∙
 	{
@@ -5775,38 +7926,53 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NameTestQNameNamespaceWithLocalReservedNameConflictNamespace
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NodeTest : NameTestQNameNamespaceWithLocalReservedNameConflictNamespace ∙
 	{
@@ -5819,22 +7985,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NameTestQNameNamespaceWithLocalReservedNameConflictLocal
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// NodeTest : NameTestQNameNamespaceWithLocalReservedNameConflictLocal ∙
 	{
@@ -5847,38 +8017,53 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NameTestQNameNamespaceWithLocalReservedNameConflictBoth
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NodeTest : NameTestQNameNamespaceWithLocalReservedNameConflictBoth ∙
 	{
@@ -5891,22 +8076,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NameTestQNameLocalOnly
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// NodeTest : NameTestQNameLocalOnly ∙
 	{
@@ -5919,38 +8108,53 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest : ∙NameTestQNameLocalOnlyReservedNameConflict
 	{
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NodeTest : NameTestQNameLocalOnlyReservedNameConflict ∙
 	{
@@ -5963,44 +8167,59 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTestAndPredicate : ∙NodeTest StepWithPredicate
 	{
 		token.T_3:  "*",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// NodeTestAndPredicate : NodeTest ∙StepWithPredicate
 	{
-		token.T_19: "[",
+		token.T_20: "[",
 	},
 	// NodeTestAndPredicate : NodeTest StepWithPredicate ∙
 	{
@@ -6013,24 +8232,28 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTestNodeTypeNoArgTest : ∙NodeType ( )
 	{
-		token.T_26: "comment",
-		token.T_37: "node",
-		token.T_42: "processing-instruction",
-		token.T_45: "text",
+		token.T_27: "comment",
+		token.T_44: "node",
+		token.T_49: "processing-instruction",
+		token.T_55: "text",
 	},
 	// NodeTestNodeTypeNoArgTest : NodeType ∙( )
 	{
@@ -6051,22 +8274,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTestProcInstTargetTest : ∙processing-instruction ( Literal )
 	{
-		token.T_42: "processing-instruction",
+		token.T_49: "processing-instruction",
 	},
 	// NodeTestProcInstTargetTest : processing-instruction ∙( Literal )
 	{
@@ -6074,8 +8301,8 @@ var first = []map[token.Type]string{
 	},
 	// NodeTestProcInstTargetTest : processing-instruction ( ∙Literal )
 	{
-		token.T_31: "doublequote",
-		token.T_44: "singlequote",
+		token.T_32: "doublequote",
+		token.T_53: "singlequote",
 	},
 	// NodeTestProcInstTargetTest : processing-instruction ( Literal ∙)
 	{
@@ -6092,22 +8319,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeType : ∙comment
 	{
-		token.T_26: "comment",
+		token.T_27: "comment",
 	},
 	// NodeType : comment ∙
 	{
@@ -6115,7 +8346,7 @@ var first = []map[token.Type]string{
 	},
 	// NodeType : ∙text
 	{
-		token.T_45: "text",
+		token.T_55: "text",
 	},
 	// NodeType : text ∙
 	{
@@ -6123,7 +8354,7 @@ var first = []map[token.Type]string{
 	},
 	// NodeType : ∙processing-instruction
 	{
-		token.T_42: "processing-instruction",
+		token.T_49: "processing-instruction",
 	},
 	// NodeType : processing-instruction ∙
 	{
@@ -6131,7 +8362,7 @@ var first = []map[token.Type]string{
 	},
 	// NodeType : ∙node
 	{
-		token.T_37: "node",
+		token.T_44: "node",
 	},
 	// NodeType : node ∙
 	{
@@ -6139,7 +8370,7 @@ var first = []map[token.Type]string{
 	},
 	// Number : ∙digits
 	{
-		token.T_29: "digits",
+		token.T_30: "digits",
 	},
 	// Number : digits ∙
 	{
@@ -6152,18 +8383,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// Number : ∙. digits
 	{
@@ -6171,7 +8406,7 @@ var first = []map[token.Type]string{
 	},
 	// Number : . ∙digits
 	{
-		token.T_29: "digits",
+		token.T_30: "digits",
 	},
 	// Number : . digits ∙
 	{
@@ -6184,22 +8419,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// Number : ∙digits . digits
 	{
-		token.T_29: "digits",
+		token.T_30: "digits",
 	},
 	// Number : digits ∙. digits
 	{
@@ -6207,7 +8446,7 @@ var first = []map[token.Type]string{
 	},
 	// Number : digits . ∙digits
 	{
-		token.T_29: "digits",
+		token.T_30: "digits",
 	},
 	// Number : digits . digits ∙
 	{
@@ -6220,18 +8459,22 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// OrExpr : ∙AndExpr
 	{
@@ -6242,37 +8485,51 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// OrExpr : AndExpr ∙
 	{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_20: "]",
-		token.T_38: "or",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// OrExpr : ∙OrExprOr
 	{
@@ -6283,37 +8540,51 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// OrExpr : OrExprOr ∙
 	{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_20: "]",
-		token.T_38: "or",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// OrExprOr : ∙OrExpr or AndExpr
 	{
@@ -6324,33 +8595,44 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// OrExprOr : OrExpr ∙or AndExpr
 	{
-		token.T_38: "or",
+		token.T_45: "or",
 	},
 	// OrExprOr : OrExpr or ∙AndExpr
 	{
@@ -6361,37 +8643,51 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// OrExprOr : OrExpr or AndExpr ∙
 	{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_20: "]",
-		token.T_38: "or",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// PathExpr : ∙LocationPath
 	{
@@ -6400,25 +8696,36 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// PathExpr : LocationPath ∙
 	{
@@ -6429,27 +8736,31 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PathExpr : ∙FilterExpr
 	{
 		token.T_1:  "(",
 		token.T_7:  ".",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_36: "ncname",
-		token.T_44: "singlequote",
-		token.T_46: "variableReference",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_43: "ncname",
+		token.T_53: "singlequote",
+		token.T_58: "variableReference",
 	},
 	// PathExpr : FilterExpr ∙
 	{
@@ -6460,27 +8771,31 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PathExpr : ∙PathExprFilterWithPath
 	{
 		token.T_1:  "(",
 		token.T_7:  ".",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_36: "ncname",
-		token.T_44: "singlequote",
-		token.T_46: "variableReference",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_43: "ncname",
+		token.T_53: "singlequote",
+		token.T_58: "variableReference",
 	},
 	// PathExpr : PathExprFilterWithPath ∙
 	{
@@ -6491,27 +8806,31 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PathExpr : ∙PathExprFilterWithAbbreviatedPath
 	{
 		token.T_1:  "(",
 		token.T_7:  ".",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_36: "ncname",
-		token.T_44: "singlequote",
-		token.T_46: "variableReference",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_43: "ncname",
+		token.T_53: "singlequote",
+		token.T_58: "variableReference",
 	},
 	// PathExpr : PathExprFilterWithAbbreviatedPath ∙
 	{
@@ -6522,27 +8841,66 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// PathExpr : ∙PathExprFilterWithExprStep
+	{
+		token.T_1:  "(",
+		token.T_7:  ".",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_43: "ncname",
+		token.T_53: "singlequote",
+		token.T_58: "variableReference",
+	},
+	// PathExpr : PathExprFilterWithExprStep ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PathExprFilterWithAbbreviatedPath : ∙FilterExpr // RelativeLocationPath
 	{
 		token.T_1:  "(",
 		token.T_7:  ".",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_36: "ncname",
-		token.T_44: "singlequote",
-		token.T_46: "variableReference",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_43: "ncname",
+		token.T_53: "singlequote",
+		token.T_58: "variableReference",
 	},
 	// PathExprFilterWithAbbreviatedPath : FilterExpr ∙// RelativeLocationPath
 	{
@@ -6553,25 +8911,36 @@ var first = []map[token.Type]string{
 		token.T_3:  "*",
 		token.T_7:  ".",
 		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// PathExprFilterWithAbbreviatedPath : FilterExpr // RelativeLocationPath ∙
 	{
@@ -6582,27 +8951,122 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// PathExprFilterWithExprStep : ∙FilterExpr / ( Expr )
+	{
+		token.T_1:  "(",
+		token.T_7:  ".",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_43: "ncname",
+		token.T_53: "singlequote",
+		token.T_58: "variableReference",
+	},
+	// PathExprFilterWithExprStep : FilterExpr ∙/ ( Expr )
+	{
+		token.T_9: "/",
+	},
+	// PathExprFilterWithExprStep : FilterExpr / ∙( Expr )
+	{
+		token.T_1: "(",
+	},
+	// PathExprFilterWithExprStep : FilterExpr / ( ∙Expr )
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// PathExprFilterWithExprStep : FilterExpr / ( Expr ∙)
+	{
+		token.T_2: ")",
+	},
+	// PathExprFilterWithExprStep : FilterExpr / ( Expr ) ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PathExprFilterWithPath : ∙FilterExpr / RelativeLocationPath
 	{
 		token.T_1:  "(",
 		token.T_7:  ".",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_36: "ncname",
-		token.T_44: "singlequote",
-		token.T_46: "variableReference",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_43: "ncname",
+		token.T_53: "singlequote",
+		token.T_58: "variableReference",
 	},
 	// PathExprFilterWithPath : FilterExpr ∙/ RelativeLocationPath
 	{
@@ -6613,25 +9077,36 @@ var first = []map[token.Type]string{
 		token.T_3:  "*",
 		token.T_7:  ".",
 		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// PathExprFilterWithPath : FilterExpr / RelativeLocationPath ∙
 	{
@@ -6642,23 +9117,27 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// Predicate : ∙[ OrExpr ]
+	// Predicate : ∙[ Expr ]
 	{
-		token.T_19: "[",
+		token.T_20: "[",
 	},
-	// Predicate : [ ∙OrExpr ]
+	// Predicate : [ ∙Expr ]
 	{
 		token.T_1:  "(",
 		token.T_3:  "*",
@@ -6667,35 +9146,78 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// Predicate : [ Expr ∙]
+	{
+		token.T_21: "]",
+	},
+	// Predicate : [ Expr ] ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// Predicate : [ OrExpr ∙]
+	// PrimaryExpr : ∙PrimaryExprParenthetic
 	{
-		token.T_20: "]",
+		token.T_1: "(",
 	},
-	// Predicate : [ OrExpr ] ∙
+	// PrimaryExpr : PrimaryExprParenthetic ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -6706,24 +9228,28 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// PrimaryExpr : ∙PrimaryExprParenthetic
+	// PrimaryExpr : ∙PrimaryExprEmptySequence
 	{
 		token.T_1: "(",
 	},
-	// PrimaryExpr : PrimaryExprParenthetic ∙
+	// PrimaryExpr : PrimaryExprEmptySequence ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -6734,23 +9260,27 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PrimaryExpr : ∙Literal
 	{
-		token.T_31: "doublequote",
-		token.T_44: "singlequote",
+		token.T_32: "doublequote",
+		token.T_53: "singlequote",
 	},
 	// PrimaryExpr : Literal ∙
 	{
@@ -6763,23 +9293,27 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PrimaryExpr : ∙Number
 	{
 		token.T_7:  ".",
-		token.T_29: "digits",
+		token.T_30: "digits",
 	},
 	// PrimaryExpr : Number ∙
 	{
@@ -6792,22 +9326,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PrimaryExpr : ∙VariableReference
 	{
-		token.T_46: "variableReference",
+		token.T_58: "variableReference",
 	},
 	// PrimaryExpr : VariableReference ∙
 	{
@@ -6820,22 +9358,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PrimaryExpr : ∙FunctionCall
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// PrimaryExpr : FunctionCall ∙
 	{
@@ -6848,24 +9390,64 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// PrimaryExprParenthetic : ∙( OrExpr )
+	// PrimaryExprEmptySequence : ∙( )
 	{
 		token.T_1: "(",
 	},
-	// PrimaryExprParenthetic : ( ∙OrExpr )
+	// PrimaryExprEmptySequence : ( ∙)
+	{
+		token.T_2: ")",
+	},
+	// PrimaryExprEmptySequence : ( ) ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// PrimaryExprParenthetic : ∙( Expr )
+	{
+		token.T_1: "(",
+	},
+	// PrimaryExprParenthetic : ( ∙Expr )
 	{
 		token.T_1:  "(",
 		token.T_3:  "*",
@@ -6874,35 +9456,46 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
-	},
-	// PrimaryExprParenthetic : ( OrExpr ∙)
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// PrimaryExprParenthetic : ( Expr ∙)
 	{
 		token.T_2: ")",
 	},
-	// PrimaryExprParenthetic : ( OrExpr ) ∙
+	// PrimaryExprParenthetic : ( Expr ) ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -6913,22 +9506,26 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// QName : ∙QNameLocalOnly
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// QName : QNameLocalOnly ∙
 	{
@@ -6936,7 +9533,7 @@ var first = []map[token.Type]string{
 	},
 	// QName : ∙QNameNamespaceWithLocal
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// QName : QNameNamespaceWithLocal ∙
 	{
@@ -6944,7 +9541,7 @@ var first = []map[token.Type]string{
 	},
 	// QNameLocalOnly : ∙ncname
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// QNameLocalOnly : ncname ∙
 	{
@@ -6952,7 +9549,7 @@ var first = []map[token.Type]string{
 	},
 	// QNameNamespaceWithLocal : ∙ncname : ncname
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// QNameNamespaceWithLocal : ncname ∙: ncname
 	{
@@ -6960,13 +9557,101 @@ var first = []map[token.Type]string{
 	},
 	// QNameNamespaceWithLocal : ncname : ∙ncname
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// QNameNamespaceWithLocal : ncname : ncname ∙
 	{
 		token.T_1: "(",
 	},
-	// RelationalExpr : ∙AdditiveExpr
+	// QuantifiedExpr : ∙QuantifiedExprSome
+	{
+		token.T_54: "some",
+	},
+	// QuantifiedExpr : QuantifiedExprSome ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// QuantifiedExpr : ∙QuantifiedExprEvery
+	{
+		token.T_34: "every",
+	},
+	// QuantifiedExpr : QuantifiedExprEvery ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// QuantifiedExprEvery : ∙every VariableReference in ExprSingle satisfies ExprSingle
+	{
+		token.T_34: "every",
+	},
+	// QuantifiedExprEvery : every ∙VariableReference in ExprSingle satisfies ExprSingle
+	{
+		token.T_58: "variableReference",
+	},
+	// QuantifiedExprEvery : every VariableReference ∙in ExprSingle satisfies ExprSingle
+	{
+		token.T_39: "in",
+	},
+	// QuantifiedExprEvery : every VariableReference in ∙ExprSingle satisfies ExprSingle
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// QuantifiedExprEvery : every VariableReference in ExprSingle ∙satisfies ExprSingle
+	{
+		token.T_51: "satisfies",
+	},
+	// QuantifiedExprEvery : every VariableReference in ExprSingle satisfies ∙ExprSingle
 	{
 		token.T_1:  "(",
 		token.T_3:  "*",
@@ -6975,44 +9660,460 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
-	},
-	// RelationalExpr : AdditiveExpr ∙
-	{
-		token.T_0:  "!=",
-		token.EOF:  "$",
-		token.T_2:  ")",
-		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// QuantifiedExprEvery : every VariableReference in ExprSingle satisfies ExprSingle ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// QuantifiedExprSome : ∙some VariableReference in ExprSingle satisfies ExprSingle
+	{
+		token.T_54: "some",
+	},
+	// QuantifiedExprSome : some ∙VariableReference in ExprSingle satisfies ExprSingle
+	{
+		token.T_58: "variableReference",
+	},
+	// QuantifiedExprSome : some VariableReference ∙in ExprSingle satisfies ExprSingle
+	{
+		token.T_39: "in",
+	},
+	// QuantifiedExprSome : some VariableReference in ∙ExprSingle satisfies ExprSingle
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// QuantifiedExprSome : some VariableReference in ExprSingle ∙satisfies ExprSingle
+	{
+		token.T_51: "satisfies",
+	},
+	// QuantifiedExprSome : some VariableReference in ExprSingle satisfies ∙ExprSingle
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// QuantifiedExprSome : some VariableReference in ExprSingle satisfies ExprSingle ∙
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// RangeExpr : ∙AdditiveExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RangeExpr : AdditiveExpr ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// RangeExpr : ∙RangeExprTo
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RangeExpr : RangeExprTo ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// RangeExprTo : ∙AdditiveExpr to AdditiveExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RangeExprTo : AdditiveExpr ∙to AdditiveExpr
+	{
+		token.T_57: "to",
+	},
+	// RangeExprTo : AdditiveExpr to ∙AdditiveExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RangeExprTo : AdditiveExpr to AdditiveExpr ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// RelationalExpr : ∙RangeExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RelationalExpr : RangeExpr ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// RelationalExpr : ∙RelationalExprLessThan
 	{
@@ -7023,29 +10124,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// RelationalExpr : RelationalExprLessThan ∙
 	{
@@ -7053,14 +10165,17 @@ var first = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// RelationalExpr : ∙RelationalExprGreaterThan
 	{
@@ -7071,29 +10186,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// RelationalExpr : RelationalExprGreaterThan ∙
 	{
@@ -7101,14 +10227,17 @@ var first = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// RelationalExpr : ∙RelationalExprLessThanOrEqual
 	{
@@ -7119,29 +10248,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// RelationalExpr : RelationalExprLessThanOrEqual ∙
 	{
@@ -7149,14 +10289,17 @@ var first = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// RelationalExpr : ∙RelationalExprGreaterThanOrEqual
 	{
@@ -7167,29 +10310,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// RelationalExpr : RelationalExprGreaterThanOrEqual ∙
 	{
@@ -7197,16 +10351,177 @@ var first = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
-	// RelationalExprGreaterThan : ∙RelationalExpr > AdditiveExpr
+	// RelationalExprGreaterThan : ∙RelationalExpr > RangeExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RelationalExprGreaterThan : RelationalExpr ∙> RangeExpr
+	{
+		token.T_17: ">",
+	},
+	// RelationalExprGreaterThan : RelationalExpr > ∙RangeExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RelationalExprGreaterThan : RelationalExpr > RangeExpr ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// RelationalExprGreaterThanOrEqual : ∙RelationalExpr >= RangeExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RelationalExprGreaterThanOrEqual : RelationalExpr ∙>= RangeExpr
+	{
+		token.T_18: ">=",
+	},
+	// RelationalExprGreaterThanOrEqual : RelationalExpr >= ∙RangeExpr
 	{
 		token.T_1:  "(",
 		token.T_3:  "*",
@@ -7215,35 +10530,576 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
-	},
-	// RelationalExprGreaterThan : RelationalExpr ∙> AdditiveExpr
-	{
-		token.T_16: ">",
-	},
-	// RelationalExprGreaterThan : RelationalExpr > ∙AdditiveExpr
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RelationalExprGreaterThanOrEqual : RelationalExpr >= RangeExpr ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// RelationalExprLessThan : ∙RelationalExpr < RangeExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RelationalExprLessThan : RelationalExpr ∙< RangeExpr
+	{
+		token.T_14: "<",
+	},
+	// RelationalExprLessThan : RelationalExpr < ∙RangeExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RelationalExprLessThan : RelationalExpr < RangeExpr ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// RelationalExprLessThanOrEqual : ∙RelationalExpr <= RangeExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RelationalExprLessThanOrEqual : RelationalExpr ∙<= RangeExpr
+	{
+		token.T_15: "<=",
+	},
+	// RelationalExprLessThanOrEqual : RelationalExpr <= ∙RangeExpr
+	{
+		token.T_1:  "(",
+		token.T_3:  "*",
+		token.T_6:  "-",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RelationalExprLessThanOrEqual : RelationalExpr <= RangeExpr ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// RelativeLocationPath : ∙Step
+	{
+		token.T_3:  "*",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+	},
+	// RelativeLocationPath : Step ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// RelativeLocationPath : ∙RelativeLocationPathWithStep
+	{
+		token.T_3:  "*",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+	},
+	// RelativeLocationPath : RelativeLocationPathWithStep ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// RelativeLocationPath : ∙AbbreviatedRelativeLocationPath
+	{
+		token.T_3:  "*",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+	},
+	// RelativeLocationPath : AbbreviatedRelativeLocationPath ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// RelativeLocationPath : ∙RelativeLocationPathWithExprStep
+	{
+		token.T_3:  "*",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+	},
+	// RelativeLocationPath : RelativeLocationPathWithExprStep ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// RelativeLocationPathWithExprStep : ∙RelativeLocationPath / ( Expr )
+	{
+		token.T_3:  "*",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+	},
+	// RelativeLocationPathWithExprStep : RelativeLocationPath ∙/ ( Expr )
+	{
+		token.T_9: "/",
+	},
+	// RelativeLocationPathWithExprStep : RelativeLocationPath / ∙( Expr )
+	{
+		token.T_1: "(",
+	},
+	// RelativeLocationPathWithExprStep : RelativeLocationPath / ( ∙Expr )
 	{
 		token.T_1:  "(",
 		token.T_3:  "*",
@@ -7252,326 +11108,378 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
+	},
+	// RelativeLocationPathWithExprStep : RelativeLocationPath / ( Expr ∙)
+	{
+		token.T_2: ")",
+	},
+	// RelativeLocationPathWithExprStep : RelativeLocationPath / ( Expr ) ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// RelativeLocationPathWithStep : ∙RelativeLocationPath / Step
+	{
+		token.T_3:  "*",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+	},
+	// RelativeLocationPathWithStep : RelativeLocationPath ∙/ Step
+	{
+		token.T_9: "/",
+	},
+	// RelativeLocationPathWithStep : RelativeLocationPath / ∙Step
+	{
+		token.T_3:  "*",
+		token.T_7:  ".",
+		token.T_8:  "..",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+	},
+	// RelativeLocationPathWithStep : RelativeLocationPath / Step ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// ReservedNameConflictResolver : ∙ancestor
+	{
+		token.T_22: "ancestor",
+	},
+	// ReservedNameConflictResolver : ancestor ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_11: ":",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// ReservedNameConflictResolver : ∙ancestor-or-self
+	{
+		token.T_23: "ancestor-or-self",
 	},
-	// RelationalExprGreaterThan : RelationalExpr > AdditiveExpr ∙
+	// ReservedNameConflictResolver : ancestor-or-self ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
 		token.T_2:  ")",
-		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
-	},
-	// RelationalExprGreaterThanOrEqual : ∙RelationalExpr >= AdditiveExpr
-	{
-		token.T_1:  "(",
 		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_7:  ".",
-		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
-	},
-	// RelationalExprGreaterThanOrEqual : RelationalExpr ∙>= AdditiveExpr
-	{
-		token.T_17: ">=",
-	},
-	// RelationalExprGreaterThanOrEqual : RelationalExpr >= ∙AdditiveExpr
+		token.T_11: ":",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// ReservedNameConflictResolver : ∙attribute
 	{
-		token.T_1:  "(",
-		token.T_3:  "*",
-		token.T_6:  "-",
-		token.T_7:  ".",
-		token.T_8:  "..",
-		token.T_9:  "/",
-		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_25: "attribute",
 	},
-	// RelationalExprGreaterThanOrEqual : RelationalExpr >= AdditiveExpr ∙
+	// ReservedNameConflictResolver : attribute ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
 		token.T_2:  ")",
-		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
-	},
-	// RelationalExprLessThan : ∙RelationalExpr < AdditiveExpr
-	{
-		token.T_1:  "(",
 		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_7:  ".",
-		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
-	},
-	// RelationalExprLessThan : RelationalExpr ∙< AdditiveExpr
-	{
-		token.T_13: "<",
-	},
-	// RelationalExprLessThan : RelationalExpr < ∙AdditiveExpr
+		token.T_11: ":",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// ReservedNameConflictResolver : ∙child
 	{
-		token.T_1:  "(",
+		token.T_26: "child",
+	},
+	// ReservedNameConflictResolver : child ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
 		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_7:  ".",
-		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_11: ":",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// ReservedNameConflictResolver : ∙descendant
+	{
+		token.T_28: "descendant",
 	},
-	// RelationalExprLessThan : RelationalExpr < AdditiveExpr ∙
+	// ReservedNameConflictResolver : descendant ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
 		token.T_2:  ")",
-		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
-	},
-	// RelationalExprLessThanOrEqual : ∙RelationalExpr <= AdditiveExpr
-	{
-		token.T_1:  "(",
 		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_7:  ".",
-		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
-	},
-	// RelationalExprLessThanOrEqual : RelationalExpr ∙<= AdditiveExpr
-	{
-		token.T_14: "<=",
-	},
-	// RelationalExprLessThanOrEqual : RelationalExpr <= ∙AdditiveExpr
+		token.T_11: ":",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// ReservedNameConflictResolver : ∙descendant-or-self
 	{
-		token.T_1:  "(",
+		token.T_29: "descendant-or-self",
+	},
+	// ReservedNameConflictResolver : descendant-or-self ∙
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
 		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_7:  ".",
-		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
-	},
-	// RelationalExprLessThanOrEqual : RelationalExpr <= AdditiveExpr ∙
-	{
-		token.T_0:  "!=",
-		token.EOF:  "$",
-		token.T_2:  ")",
-		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_11: ":",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// RelativeLocationPath : ∙Step
+	// ReservedNameConflictResolver : ∙following
 	{
-		token.T_3:  "*",
-		token.T_7:  ".",
-		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_35: "following",
 	},
-	// RelativeLocationPath : Step ∙
+	// ReservedNameConflictResolver : following ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7582,44 +11490,29 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_11: ":",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// RelativeLocationPath : ∙RelativeLocationPathWithStep
+	// ReservedNameConflictResolver : ∙following-sibling
 	{
-		token.T_3:  "*",
-		token.T_7:  ".",
-		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_36: "following-sibling",
 	},
-	// RelativeLocationPath : RelativeLocationPathWithStep ∙
+	// ReservedNameConflictResolver : following-sibling ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7630,44 +11523,29 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_11: ":",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// RelativeLocationPath : ∙AbbreviatedRelativeLocationPath
+	// ReservedNameConflictResolver : ∙namespace
 	{
-		token.T_3:  "*",
-		token.T_7:  ".",
-		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_42: "namespace",
 	},
-	// RelativeLocationPath : AbbreviatedRelativeLocationPath ∙
+	// ReservedNameConflictResolver : namespace ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7678,73 +11556,62 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_11: ":",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// RelativeLocationPathWithStep : ∙RelativeLocationPath / Step
+	// ReservedNameConflictResolver : ∙parent
 	{
-		token.T_3:  "*",
-		token.T_7:  ".",
-		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_46: "parent",
 	},
-	// RelativeLocationPathWithStep : RelativeLocationPath ∙/ Step
+	// ReservedNameConflictResolver : parent ∙
 	{
-		token.T_9: "/",
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_11: ":",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// RelativeLocationPathWithStep : RelativeLocationPath / ∙Step
+	// ReservedNameConflictResolver : ∙preceding
 	{
-		token.T_3:  "*",
-		token.T_7:  ".",
-		token.T_8:  "..",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_47: "preceding",
 	},
-	// RelativeLocationPathWithStep : RelativeLocationPath / Step ∙
+	// ReservedNameConflictResolver : preceding ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7755,23 +11622,29 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_11: ":",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙ancestor
+	// ReservedNameConflictResolver : ∙preceding-sibling
 	{
-		token.T_21: "ancestor",
+		token.T_48: "preceding-sibling",
 	},
-	// ReservedNameConflictResolver : ancestor ∙
+	// ReservedNameConflictResolver : preceding-sibling ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7783,24 +11656,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙ancestor-or-self
+	// ReservedNameConflictResolver : ∙self
 	{
-		token.T_22: "ancestor-or-self",
+		token.T_52: "self",
 	},
-	// ReservedNameConflictResolver : ancestor-or-self ∙
+	// ReservedNameConflictResolver : self ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7812,24 +11689,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙attribute
+	// ReservedNameConflictResolver : ∙comment
 	{
-		token.T_24: "attribute",
+		token.T_27: "comment",
 	},
-	// ReservedNameConflictResolver : attribute ∙
+	// ReservedNameConflictResolver : comment ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7841,24 +11722,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙child
+	// ReservedNameConflictResolver : ∙text
 	{
-		token.T_25: "child",
+		token.T_55: "text",
 	},
-	// ReservedNameConflictResolver : child ∙
+	// ReservedNameConflictResolver : text ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7870,24 +11755,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙descendant
+	// ReservedNameConflictResolver : ∙processing-instruction
 	{
-		token.T_27: "descendant",
+		token.T_49: "processing-instruction",
 	},
-	// ReservedNameConflictResolver : descendant ∙
+	// ReservedNameConflictResolver : processing-instruction ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7899,24 +11788,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙descendant-or-self
+	// ReservedNameConflictResolver : ∙node
 	{
-		token.T_28: "descendant-or-self",
+		token.T_44: "node",
 	},
-	// ReservedNameConflictResolver : descendant-or-self ∙
+	// ReservedNameConflictResolver : node ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7928,24 +11821,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙following
+	// ReservedNameConflictResolver : ∙if
 	{
-		token.T_32: "following",
+		token.T_38: "if",
 	},
-	// ReservedNameConflictResolver : following ∙
+	// ReservedNameConflictResolver : if ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7957,24 +11854,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙following-sibling
+	// ReservedNameConflictResolver : ∙then
 	{
-		token.T_33: "following-sibling",
+		token.T_56: "then",
 	},
-	// ReservedNameConflictResolver : following-sibling ∙
+	// ReservedNameConflictResolver : then ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -7986,24 +11887,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙namespace
+	// ReservedNameConflictResolver : ∙else
 	{
-		token.T_35: "namespace",
+		token.T_33: "else",
 	},
-	// ReservedNameConflictResolver : namespace ∙
+	// ReservedNameConflictResolver : else ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -8015,24 +11920,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙parent
+	// ReservedNameConflictResolver : ∙let
 	{
-		token.T_39: "parent",
+		token.T_40: "let",
 	},
-	// ReservedNameConflictResolver : parent ∙
+	// ReservedNameConflictResolver : let ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -8044,24 +11953,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙preceding
+	// ReservedNameConflictResolver : ∙for
 	{
-		token.T_40: "preceding",
+		token.T_37: "for",
 	},
-	// ReservedNameConflictResolver : preceding ∙
+	// ReservedNameConflictResolver : for ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -8073,24 +11986,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙preceding-sibling
+	// ReservedNameConflictResolver : ∙in
 	{
-		token.T_41: "preceding-sibling",
+		token.T_39: "in",
 	},
-	// ReservedNameConflictResolver : preceding-sibling ∙
+	// ReservedNameConflictResolver : in ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -8102,24 +12019,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙self
+	// ReservedNameConflictResolver : ∙return
 	{
-		token.T_43: "self",
+		token.T_50: "return",
 	},
-	// ReservedNameConflictResolver : self ∙
+	// ReservedNameConflictResolver : return ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -8131,24 +12052,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙comment
+	// ReservedNameConflictResolver : ∙some
 	{
-		token.T_26: "comment",
+		token.T_54: "some",
 	},
-	// ReservedNameConflictResolver : comment ∙
+	// ReservedNameConflictResolver : some ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -8160,24 +12085,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙text
+	// ReservedNameConflictResolver : ∙every
 	{
-		token.T_45: "text",
+		token.T_34: "every",
 	},
-	// ReservedNameConflictResolver : text ∙
+	// ReservedNameConflictResolver : every ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -8189,24 +12118,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙processing-instruction
+	// ReservedNameConflictResolver : ∙satisfies
 	{
-		token.T_42: "processing-instruction",
+		token.T_51: "satisfies",
 	},
-	// ReservedNameConflictResolver : processing-instruction ∙
+	// ReservedNameConflictResolver : satisfies ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -8218,24 +12151,28 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
-	// ReservedNameConflictResolver : ∙node
+	// ReservedNameConflictResolver : ∙to
 	{
-		token.T_37: "node",
+		token.T_57: "to",
 	},
-	// ReservedNameConflictResolver : node ∙
+	// ReservedNameConflictResolver : to ∙
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
@@ -8247,40 +12184,55 @@ var first = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// Step : ∙NodeTestAndPredicate
 	{
 		token.T_3:  "*",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// Step : NodeTestAndPredicate ∙
 	{
@@ -8293,39 +12245,54 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// Step : ∙NodeTest
 	{
 		token.T_3:  "*",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// Step : NodeTest ∙
 	{
@@ -8338,34 +12305,38 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// Step : ∙StepWithAxisAndNodeTestAndPredicate
 	{
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_43: "self",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_42: "namespace",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_52: "self",
 	},
 	// Step : StepWithAxisAndNodeTestAndPredicate ∙
 	{
@@ -8378,34 +12349,38 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// Step : ∙StepWithAxisAndNodeTest
 	{
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_43: "self",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_42: "namespace",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_52: "self",
 	},
 	// Step : StepWithAxisAndNodeTest ∙
 	{
@@ -8418,17 +12393,21 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// Step : ∙AbbreviatedStep
 	{
@@ -8446,21 +12425,25 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// Step : ∙FunctionCall
 	{
-		token.T_36: "ncname",
+		token.T_43: "ncname",
 	},
 	// Step : FunctionCall ∙
 	{
@@ -8473,56 +12456,71 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// StepWithAxisAndNodeTest : ∙AxisSpecifier NodeTest
 	{
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_43: "self",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_42: "namespace",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_52: "self",
 	},
 	// StepWithAxisAndNodeTest : AxisSpecifier ∙NodeTest
 	{
 		token.T_3:  "*",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// StepWithAxisAndNodeTest : AxisSpecifier NodeTest ∙
 	{
@@ -8535,39 +12533,43 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// StepWithAxisAndNodeTestAndPredicate : ∙StepWithAxisAndNodeTest StepWithPredicate
 	{
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_43: "self",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_42: "namespace",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_52: "self",
 	},
 	// StepWithAxisAndNodeTestAndPredicate : StepWithAxisAndNodeTest ∙StepWithPredicate
 	{
-		token.T_19: "[",
+		token.T_20: "[",
 	},
 	// StepWithAxisAndNodeTestAndPredicate : StepWithAxisAndNodeTest StepWithPredicate ∙
 	{
@@ -8580,21 +12582,25 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// StepWithPredicate : ∙StepWithPredicateWithAnotherPredicate
 	{
-		token.T_19: "[",
+		token.T_20: "[",
 	},
 	// StepWithPredicate : StepWithPredicateWithAnotherPredicate ∙
 	{
@@ -8607,21 +12613,25 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// StepWithPredicate : ∙Predicate
 	{
-		token.T_19: "[",
+		token.T_20: "[",
 	},
 	// StepWithPredicate : Predicate ∙
 	{
@@ -8634,25 +12644,29 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// StepWithPredicateWithAnotherPredicate : ∙Predicate StepWithPredicate
 	{
-		token.T_19: "[",
+		token.T_20: "[",
 	},
 	// StepWithPredicateWithAnotherPredicate : Predicate ∙StepWithPredicate
 	{
-		token.T_19: "[",
+		token.T_20: "[",
 	},
 	// StepWithPredicateWithAnotherPredicate : Predicate StepWithPredicate ∙
 	{
@@ -8665,17 +12679,21 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// UnaryExpr : ∙UnionExpr
 	{
@@ -8685,29 +12703,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// UnaryExpr : UnionExpr ∙
 	{
@@ -8718,16 +12747,20 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// UnaryExpr : ∙UnaryExprNegate
 	{
@@ -8742,16 +12775,20 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// UnaryExprNegate : ∙- UnaryExpr
 	{
@@ -8766,29 +12803,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// UnaryExprNegate : - UnaryExpr ∙
 	{
@@ -8799,16 +12847,20 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// UnionExpr : ∙PathExpr
 	{
@@ -8818,29 +12870,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// UnionExpr : PathExpr ∙
 	{
@@ -8851,17 +12914,21 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// UnionExpr : ∙UnionExprUnion
 	{
@@ -8871,29 +12938,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// UnionExpr : UnionExprUnion ∙
 	{
@@ -8904,17 +12982,21 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// UnionExprUnion : ∙UnionExpr | PathExpr
 	{
@@ -8924,33 +13006,44 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// UnionExprUnion : UnionExpr ∙| PathExpr
 	{
-		token.T_47: "|",
+		token.T_59: "|",
 	},
 	// UnionExprUnion : UnionExpr | ∙PathExpr
 	{
@@ -8960,29 +13053,40 @@ var first = []map[token.Type]string{
 		token.T_8:  "..",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_18: "@",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_29: "digits",
-		token.T_31: "doublequote",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_44: "singlequote",
-		token.T_45: "text",
-		token.T_46: "variableReference",
+		token.T_19: "@",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_30: "digits",
+		token.T_32: "doublequote",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_53: "singlequote",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
+		token.T_58: "variableReference",
 	},
 	// UnionExprUnion : UnionExpr | PathExpr ∙
 	{
@@ -8993,21 +13097,25 @@ var first = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// VariableReference : ∙variableReference
 	{
-		token.T_46: "variableReference",
+		token.T_58: "variableReference",
 	},
 	// VariableReference : variableReference ∙
 	{
@@ -9020,18 +13128,24 @@ var first = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_13: ":=",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_39: "in",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 }
 
@@ -9045,39 +13159,54 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbbreviatedAxisSpecifier
 	{
 		token.T_3:  "*",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// AbbreviatedRelativeLocationPath
 	{
@@ -9090,17 +13219,21 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbbreviatedStep
 	{
@@ -9113,17 +13246,21 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbbreviatedStepParent
 	{
@@ -9136,17 +13273,21 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbbreviatedStepSelf
 	{
@@ -9159,17 +13300,21 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbsoluteLocationPath
 	{
@@ -9180,17 +13325,21 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbsoluteLocationPathOnly
 	{
@@ -9201,17 +13350,21 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AbsoluteLocationPathWithRelative
 	{
@@ -9222,17 +13375,21 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// AdditiveExpr
 	{
@@ -9242,14 +13399,18 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// AdditiveExprAdd
 	{
@@ -9259,14 +13420,18 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// AdditiveExprSubtract
 	{
@@ -9276,32 +13441,42 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// AndExpr
 	{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// AndExprAnd
 	{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// AxisName
 	{
@@ -9310,46 +13485,68 @@ var followSets = []map[token.Type]string{
 	// AxisSpecifier
 	{
 		token.T_3:  "*",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// AxisSpecifierWithAxisName
 	{
 		token.T_3:  "*",
-		token.T_21: "ancestor",
-		token.T_22: "ancestor-or-self",
-		token.T_24: "attribute",
-		token.T_25: "child",
-		token.T_26: "comment",
-		token.T_27: "descendant",
-		token.T_28: "descendant-or-self",
-		token.T_32: "following",
-		token.T_33: "following-sibling",
-		token.T_35: "namespace",
-		token.T_36: "ncname",
-		token.T_37: "node",
-		token.T_39: "parent",
-		token.T_40: "preceding",
-		token.T_41: "preceding-sibling",
-		token.T_42: "processing-instruction",
-		token.T_43: "self",
-		token.T_45: "text",
+		token.T_22: "ancestor",
+		token.T_23: "ancestor-or-self",
+		token.T_25: "attribute",
+		token.T_26: "child",
+		token.T_27: "comment",
+		token.T_28: "descendant",
+		token.T_29: "descendant-or-self",
+		token.T_33: "else",
+		token.T_34: "every",
+		token.T_35: "following",
+		token.T_36: "following-sibling",
+		token.T_37: "for",
+		token.T_38: "if",
+		token.T_39: "in",
+		token.T_40: "let",
+		token.T_42: "namespace",
+		token.T_43: "ncname",
+		token.T_44: "node",
+		token.T_46: "parent",
+		token.T_47: "preceding",
+		token.T_48: "preceding-sibling",
+		token.T_49: "processing-instruction",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_52: "self",
+		token.T_54: "some",
+		token.T_55: "text",
+		token.T_56: "then",
+		token.T_57: "to",
 	},
 	// EqualityExpr
 	{
@@ -9357,10 +13554,13 @@ var followSets = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_15: "=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_16: "=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// EqualityExprEqual
 	{
@@ -9368,10 +13568,13 @@ var followSets = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_15: "=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_16: "=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// EqualityExprNotEqual
 	{
@@ -9379,10 +13582,35 @@ var followSets = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_15: "=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_16: "=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// Expr
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_21: "]",
+	},
+	// ExprComma
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_21: "]",
+	},
+	// ExprSingle
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// FilterExpr
 	{
@@ -9395,18 +13623,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FilterExprWithPredicate
 	{
@@ -9419,18 +13651,32 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// ForExpr
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// FunctionCall
 	{
@@ -9443,18 +13689,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FunctionCallArgumentList
 	{
@@ -9467,18 +13717,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FunctionCallArgumentListArgWithNext
 	{
@@ -9491,18 +13745,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FunctionCallArgumentListEndArg
 	{
@@ -9515,18 +13773,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FunctionSignature
 	{
@@ -9539,18 +13801,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// FunctionSignatureNoArgs
 	{
@@ -9563,18 +13829,42 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// IfExpr
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// LetExpr
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// Literal
 	{
@@ -9587,18 +13877,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// LocationPath
 	{
@@ -9609,17 +13903,21 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// MultiplicativeExpr
 	{
@@ -9630,16 +13928,20 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// MultiplicativeExprDivide
 	{
@@ -9650,16 +13952,20 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// MultiplicativeExprMod
 	{
@@ -9670,16 +13976,20 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// MultiplicativeExprMultiply
 	{
@@ -9690,16 +14000,20 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// NameTestAnyElement
 	{
@@ -9712,18 +14026,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestLocalAnyNamespace
 	{
@@ -9736,18 +14054,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestLocalAnyNamespaceReservedNameConflict
 	{
@@ -9760,18 +14082,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestNamespaceAnyLocal
 	{
@@ -9784,18 +14110,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestNamespaceAnyLocalReservedNameConflict
 	{
@@ -9808,18 +14138,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameLocalOnly
 	{
@@ -9832,18 +14166,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameLocalOnlyReservedNameConflict
 	{
@@ -9856,18 +14194,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameNamespaceWithLocal
 	{
@@ -9880,18 +14222,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictBoth
 	{
@@ -9904,18 +14250,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictLocal
 	{
@@ -9928,18 +14278,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NameTestQNameNamespaceWithLocalReservedNameConflictNamespace
 	{
@@ -9952,18 +14306,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTest
 	{
@@ -9976,18 +14334,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTestAndPredicate
 	{
@@ -10000,17 +14362,21 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTestNodeTypeNoArgTest
 	{
@@ -10023,18 +14389,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeTestProcInstTargetTest
 	{
@@ -10047,18 +14417,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// NodeType
 	{
@@ -10075,34 +14449,44 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// OrExpr
 	{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_20: "]",
-		token.T_38: "or",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// OrExprOr
 	{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_20: "]",
-		token.T_38: "or",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// PathExpr
 	{
@@ -10113,17 +14497,21 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PathExprFilterWithAbbreviatedPath
 	{
@@ -10134,17 +14522,46 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// PathExprFilterWithExprStep
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PathExprFilterWithPath
 	{
@@ -10155,17 +14572,21 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// Predicate
 	{
@@ -10178,18 +14599,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PrimaryExpr
 	{
@@ -10202,18 +14627,50 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// PrimaryExprEmptySequence
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// PrimaryExprParenthetic
 	{
@@ -10226,18 +14683,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// QName
 	{
@@ -10251,20 +14712,89 @@ var followSets = []map[token.Type]string{
 	{
 		token.T_1: "(",
 	},
+	// QuantifiedExpr
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// QuantifiedExprEvery
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// QuantifiedExprSome
+	{
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_21: "]",
+		token.T_33: "else",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// RangeExpr
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
+	// RangeExprTo
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_5:  ",",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+	},
 	// RelationalExpr
 	{
 		token.T_0:  "!=",
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// RelationalExprGreaterThan
 	{
@@ -10272,14 +14802,17 @@ var followSets = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// RelationalExprGreaterThanOrEqual
 	{
@@ -10287,14 +14820,17 @@ var followSets = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// RelationalExprLessThan
 	{
@@ -10302,14 +14838,17 @@ var followSets = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// RelationalExprLessThanOrEqual
 	{
@@ -10317,14 +14856,17 @@ var followSets = []map[token.Type]string{
 		token.EOF:  "$",
 		token.T_2:  ")",
 		token.T_5:  ",",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_33: "else",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
 	},
 	// RelativeLocationPath
 	{
@@ -10337,17 +14879,48 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
+	},
+	// RelativeLocationPathWithExprStep
+	{
+		token.T_0:  "!=",
+		token.EOF:  "$",
+		token.T_2:  ")",
+		token.T_3:  "*",
+		token.T_4:  "+",
+		token.T_5:  ",",
+		token.T_6:  "-",
+		token.T_9:  "/",
+		token.T_10: "//",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// RelativeLocationPathWithStep
 	{
@@ -10360,17 +14933,21 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// ReservedNameConflictResolver
 	{
@@ -10384,18 +14961,22 @@ var followSets = []map[token.Type]string{
 		token.T_9:  "/",
 		token.T_10: "//",
 		token.T_11: ":",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// Step
 	{
@@ -10408,17 +14989,21 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// StepWithAxisAndNodeTest
 	{
@@ -10431,18 +15016,22 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// StepWithAxisAndNodeTestAndPredicate
 	{
@@ -10455,17 +15044,21 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// StepWithPredicate
 	{
@@ -10478,17 +15071,21 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// StepWithPredicateWithAnotherPredicate
 	{
@@ -10501,17 +15098,21 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// UnaryExpr
 	{
@@ -10522,16 +15123,20 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// UnaryExprNegate
 	{
@@ -10542,16 +15147,20 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
 	},
 	// UnionExpr
 	{
@@ -10562,17 +15171,21 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// UnionExprUnion
 	{
@@ -10583,17 +15196,21 @@ var followSets = []map[token.Type]string{
 		token.T_4:  "+",
 		token.T_5:  ",",
 		token.T_6:  "-",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 	// VariableReference
 	{
@@ -10606,18 +15223,24 @@ var followSets = []map[token.Type]string{
 		token.T_6:  "-",
 		token.T_9:  "/",
 		token.T_10: "//",
-		token.T_13: "<",
-		token.T_14: "<=",
-		token.T_15: "=",
-		token.T_16: ">",
-		token.T_17: ">=",
-		token.T_19: "[",
-		token.T_20: "]",
-		token.T_23: "and",
-		token.T_30: "div",
-		token.T_34: "mod",
-		token.T_38: "or",
-		token.T_47: "|",
+		token.T_13: ":=",
+		token.T_14: "<",
+		token.T_15: "<=",
+		token.T_16: "=",
+		token.T_17: ">",
+		token.T_18: ">=",
+		token.T_20: "[",
+		token.T_21: "]",
+		token.T_24: "and",
+		token.T_31: "div",
+		token.T_33: "else",
+		token.T_39: "in",
+		token.T_41: "mod",
+		token.T_45: "or",
+		token.T_50: "return",
+		token.T_51: "satisfies",
+		token.T_57: "to",
+		token.T_59: "|",
 	},
 }
 
@@ -109,6 +109,24 @@ const(
 	EqualityExprNotEqual0R1
 	EqualityExprNotEqual0R2
 	EqualityExprNotEqual0R3
+	Expr0R0
+	Expr0R1
+	Expr1R0
+	Expr1R1
+	ExprComma0R0
+	ExprComma0R1
+	ExprComma0R2
+	ExprComma0R3
+	ExprSingle0R0
+	ExprSingle0R1
+	ExprSingle1R0
+	ExprSingle1R1
+	ExprSingle2R0
+	ExprSingle2R1
+	ExprSingle3R0
+	ExprSingle3R1
+	ExprSingle4R0
+	ExprSingle4R1
 	FilterExpr0R0
 	FilterExpr0R1
 	FilterExpr1R0
@@ -116,6 +134,13 @@ const(
 	FilterExprWithPredicate0R0
 	FilterExprWithPredicate0R1
 	FilterExprWithPredicate0R2
+	ForExpr0R0
+	ForExpr0R1
+	ForExpr0R2
+	ForExpr0R3
+	ForExpr0R4
+	ForExpr0R5
+	ForExpr0R6
 	FunctionCall0R0
 	FunctionCall0R1
 	FunctionCall0R2
@@ -137,6 +162,22 @@ const(
 	FunctionSignature1R1
 	FunctionSignatureNoArgs0R0
 	FunctionSignatureNoArgs0R1
+	IfExpr0R0
+	IfExpr0R1
+	IfExpr0R2
+	IfExpr0R3
+	IfExpr0R4
+	IfExpr0R5
+	IfExpr0R6
+	IfExpr0R7
+	IfExpr0R8
+	LetExpr0R0
+	LetExpr0R1
+	LetExpr0R2
+	LetExpr0R3
+	LetExpr0R4
+	LetExpr0R5
+	LetExpr0R6
 	Literal0R0
 	Literal0R1
 	Literal1R0
@@ -274,10 +315,18 @@ const(
 	PathExpr2R1
 	PathExpr3R0
 	PathExpr3R1
+	PathExpr4R0
+	PathExpr4R1
 	PathExprFilterWithAbbreviatedPath0R0
 	PathExprFilterWithAbbreviatedPath0R1
 	PathExprFilterWithAbbreviatedPath0R2
 	PathExprFilterWithAbbreviatedPath0R3
+	PathExprFilterWithExprStep0R0
+	PathExprFilterWithExprStep0R1
+	PathExprFilterWithExprStep0R2
+	PathExprFilterWithExprStep0R3
+	PathExprFilterWithExprStep0R4
+	PathExprFilterWithExprStep0R5
 	PathExprFilterWithPath0R0
 	PathExprFilterWithPath0R1
 	PathExprFilterWithPath0R2
@@ -296,6 +345,11 @@ const(
 	PrimaryExpr3R1
 	PrimaryExpr4R0
 	PrimaryExpr4R1
+	PrimaryExpr5R0
+	PrimaryExpr5R1
+	PrimaryExprEmptySequence0R0
+	PrimaryExprEmptySequence0R1
+	PrimaryExprEmptySequence0R2
 	PrimaryExprParenthetic0R0
 	PrimaryExprParenthetic0R1
 	PrimaryExprParenthetic0R2
@@ -310,6 +364,32 @@ const(
 	QNameNamespaceWithLocal0R1
 	QNameNamespaceWithLocal0R2
 	QNameNamespaceWithLocal0R3
+	QuantifiedExpr0R0
+	QuantifiedExpr0R1
+	QuantifiedExpr1R0
+	QuantifiedExpr1R1
+	QuantifiedExprEvery0R0
+	QuantifiedExprEvery0R1
+	QuantifiedExprEvery0R2
+	QuantifiedExprEvery0R3
+	QuantifiedExprEvery0R4
+	QuantifiedExprEvery0R5
+	QuantifiedExprEvery0R6
+	QuantifiedExprSome0R0
+	QuantifiedExprSome0R1
+	QuantifiedExprSome0R2
+	QuantifiedExprSome0R3
+	QuantifiedExprSome0R4
+	QuantifiedExprSome0R5
+	QuantifiedExprSome0R6
+	RangeExpr0R0
+	RangeExpr0R1
+	RangeExpr1R0
+	RangeExpr1R1
+	RangeExprTo0R0
+	RangeExprTo0R1
+	RangeExprTo0R2
+	RangeExprTo0R3
 	RelationalExpr0R0
 	RelationalExpr0R1
 	RelationalExpr1R0
@@ -342,6 +422,14 @@ const(
 	RelativeLocationPath1R1
 	RelativeLocationPath2R0
 	RelativeLocationPath2R1
+	RelativeLocationPath3R0
+	RelativeLocationPath3R1
+	RelativeLocationPathWithExprStep0R0
+	RelativeLocationPathWithExprStep0R1
+	RelativeLocationPathWithExprStep0R2
+	RelativeLocationPathWithExprStep0R3
+	RelativeLocationPathWithExprStep0R4
+	RelativeLocationPathWithExprStep0R5
 	RelativeLocationPathWithStep0R0
 	RelativeLocationPathWithStep0R1
 	RelativeLocationPathWithStep0R2
@@ -380,6 +468,28 @@ const(
 	ReservedNameConflictResolver15R1
 	ReservedNameConflictResolver16R0
 	ReservedNameConflictResolver16R1
+	ReservedNameConflictResolver17R0
+	ReservedNameConflictResolver17R1
+	ReservedNameConflictResolver18R0
+	ReservedNameConflictResolver18R1
+	ReservedNameConflictResolver19R0
+	ReservedNameConflictResolver19R1
+	ReservedNameConflictResolver20R0
+	ReservedNameConflictResolver20R1
+	ReservedNameConflictResolver21R0
+	ReservedNameConflictResolver21R1
+	ReservedNameConflictResolver22R0
+	ReservedNameConflictResolver22R1
+	ReservedNameConflictResolver23R0
+	ReservedNameConflictResolver23R1
+	ReservedNameConflictResolver24R0
+	ReservedNameConflictResolver24R1
+	ReservedNameConflictResolver25R0
+	ReservedNameConflictResolver25R1
+	ReservedNameConflictResolver26R0
+	ReservedNameConflictResolver26R1
+	ReservedNameConflictResolver27R0
+	ReservedNameConflictResolver27R1
 	Step0R0
 	Step0R1
 	Step1R0
@@ -538,14 +648,14 @@ var slots = map[Label]*Slot{
 	AbbreviatedAxisSpecifier0R0: {
 		symbols.NT_AbbreviatedAxisSpecifier, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_18,
+			symbols.T_19,
 		}, 
 		AbbreviatedAxisSpecifier0R0, 
 	},
 	AbbreviatedAxisSpecifier0R1: {
 		symbols.NT_AbbreviatedAxisSpecifier, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_18,
+			symbols.T_19,
 		}, 
 		AbbreviatedAxisSpecifier0R1, 
 	},
@@ -867,7 +977,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_AndExprAnd, 0, 0, 
 		symbols.Symbols{  
 			symbols.NT_AndExpr, 
-			symbols.T_23, 
+			symbols.T_24, 
 			symbols.NT_EqualityExpr,
 		}, 
 		AndExprAnd0R0, 
@@ -876,7 +986,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_AndExprAnd, 0, 1, 
 		symbols.Symbols{  
 			symbols.NT_AndExpr, 
-			symbols.T_23, 
+			symbols.T_24, 
 			symbols.NT_EqualityExpr,
 		}, 
 		AndExprAnd0R1, 
@@ -885,7 +995,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_AndExprAnd, 0, 2, 
 		symbols.Symbols{  
 			symbols.NT_AndExpr, 
-			symbols.T_23, 
+			symbols.T_24, 
 			symbols.NT_EqualityExpr,
 		}, 
 		AndExprAnd0R2, 
@@ -894,7 +1004,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_AndExprAnd, 0, 3, 
 		symbols.Symbols{  
 			symbols.NT_AndExpr, 
-			symbols.T_23, 
+			symbols.T_24, 
 			symbols.NT_EqualityExpr,
 		}, 
 		AndExprAnd0R3, 
@@ -902,182 +1012,182 @@ var slots = map[Label]*Slot{
 	AxisName0R0: {
 		symbols.NT_AxisName, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_21,
+			symbols.T_22,
 		}, 
 		AxisName0R0, 
 	},
 	AxisName0R1: {
 		symbols.NT_AxisName, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_21,
+			symbols.T_22,
 		}, 
 		AxisName0R1, 
 	},
 	AxisName1R0: {
 		symbols.NT_AxisName, 1, 0, 
 		symbols.Symbols{  
-			symbols.T_22,
+			symbols.T_23,
 		}, 
 		AxisName1R0, 
 	},
 	AxisName1R1: {
 		symbols.NT_AxisName, 1, 1, 
 		symbols.Symbols{  
-			symbols.T_22,
+			symbols.T_23,
 		}, 
 		AxisName1R1, 
 	},
 	AxisName2R0: {
 		symbols.NT_AxisName, 2, 0, 
 		symbols.Symbols{  
-			symbols.T_24,
+			symbols.T_25,
 		}, 
 		AxisName2R0, 
 	},
 	AxisName2R1: {
 		symbols.NT_AxisName, 2, 1, 
 		symbols.Symbols{  
-			symbols.T_24,
+			symbols.T_25,
 		}, 
 		AxisName2R1, 
 	},
 	AxisName3R0: {
 		symbols.NT_AxisName, 3, 0, 
 		symbols.Symbols{  
-			symbols.T_25,
+			symbols.T_26,
 		}, 
 		AxisName3R0, 
 	},
 	AxisName3R1: {
 		symbols.NT_AxisName, 3, 1, 
 		symbols.Symbols{  
-			symbols.T_25,
+			symbols.T_26,
 		}, 
 		AxisName3R1, 
 	},
 	AxisName4R0: {
 		symbols.NT_AxisName, 4, 0, 
 		symbols.Symbols{  
-			symbols.T_27,
+			symbols.T_28,
 		}, 
 		AxisName4R0, 
 	},
 	AxisName4R1: {
 		symbols.NT_AxisName, 4, 1, 
 		symbols.Symbols{  
-			symbols.T_27,
+			symbols.T_28,
 		}, 
 		AxisName4R1, 
 	},
 	AxisName5R0: {
 		symbols.NT_AxisName, 5, 0, 
 		symbols.Symbols{  
-			symbols.T_28,
+			symbols.T_29,
 		}, 
 		AxisName5R0, 
 	},
 	AxisName5R1: {
 		symbols.NT_AxisName, 5, 1, 
 		symbols.Symbols{  
-			symbols.T_28,
+			symbols.T_29,
 		}, 
 		AxisName5R1, 
 	},
 	AxisName6R0: {
 		symbols.NT_AxisName, 6, 0, 
 		symbols.Symbols{  
-			symbols.T_32,
+			symbols.T_35,
 		}, 
 		AxisName6R0, 
 	},
 	AxisName6R1: {
 		symbols.NT_AxisName, 6, 1, 
 		symbols.Symbols{  
-			symbols.T_32,
+			symbols.T_35,
 		}, 
 		AxisName6R1, 
 	},
 	AxisName7R0: {
 		symbols.NT_AxisName, 7, 0, 
 		symbols.Symbols{  
-			symbols.T_33,
+			symbols.T_36,
 		}, 
 		AxisName7R0, 
 	},
 	AxisName7R1: {
 		symbols.NT_AxisName, 7, 1, 
 		symbols.Symbols{  
-			symbols.T_33,
+			symbols.T_36,
 		}, 
 		AxisName7R1, 
 	},
 	AxisName8R0: {
 		symbols.NT_AxisName, 8, 0, 
 		symbols.Symbols{  
-			symbols.T_35,
+			symbols.T_42,
 		}, 
 		AxisName8R0, 
 	},
 	AxisName8R1: {
 		symbols.NT_AxisName, 8, 1, 
 		symbols.Symbols{  
-			symbols.T_35,
+			symbols.T_42,
 		}, 
 		AxisName8R1, 
 	},
 	AxisName9R0: {
 		symbols.NT_AxisName, 9, 0, 
 		symbols.Symbols{  
-			symbols.T_39,
+			symbols.T_46,
 		}, 
 		AxisName9R0, 
 	},
 	AxisName9R1: {
 		symbols.NT_AxisName, 9, 1, 
 		symbols.Symbols{  
-			symbols.T_39,
+			symbols.T_46,
 		}, 
 		AxisName9R1, 
 	},
 	AxisName10R0: {
 		symbols.NT_AxisName, 10, 0, 
 		symbols.Symbols{  
-			symbols.T_40,
+			symbols.T_47,
 		}, 
 		AxisName10R0, 
 	},
 	AxisName10R1: {
 		symbols.NT_AxisName, 10, 1, 
 		symbols.Symbols{  
-			symbols.T_40,
+			symbols.T_47,
 		}, 
 		AxisName10R1, 
 	},
 	AxisName11R0: {
 		symbols.NT_AxisName, 11, 0, 
 		symbols.Symbols{  
-			symbols.T_41,
+			symbols.T_48,
 		}, 
 		AxisName11R0, 
 	},
 	AxisName11R1: {
 		symbols.NT_AxisName, 11, 1, 
 		symbols.Symbols{  
-			symbols.T_41,
+			symbols.T_48,
 		}, 
 		AxisName11R1, 
 	},
 	AxisName12R0: {
 		symbols.NT_AxisName, 12, 0, 
 		symbols.Symbols{  
-			symbols.T_43,
+			symbols.T_52,
 		}, 
 		AxisName12R0, 
 	},
 	AxisName12R1: {
 		symbols.NT_AxisName, 12, 1, 
 		symbols.Symbols{  
-			symbols.T_43,
+			symbols.T_52,
 		}, 
 		AxisName12R1, 
 	},
@@ -1179,7 +1289,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_EqualityExprEqual, 0, 0, 
 		symbols.Symbols{  
 			symbols.NT_EqualityExpr, 
-			symbols.T_15, 
+			symbols.T_16, 
 			symbols.NT_RelationalExpr,
 		}, 
 		EqualityExprEqual0R0, 
@@ -1188,7 +1298,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_EqualityExprEqual, 0, 1, 
 		symbols.Symbols{  
 			symbols.NT_EqualityExpr, 
-			symbols.T_15, 
+			symbols.T_16, 
 			symbols.NT_RelationalExpr,
 		}, 
 		EqualityExprEqual0R1, 
@@ -1197,7 +1307,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_EqualityExprEqual, 0, 2, 
 		symbols.Symbols{  
 			symbols.NT_EqualityExpr, 
-			symbols.T_15, 
+			symbols.T_16, 
 			symbols.NT_RelationalExpr,
 		}, 
 		EqualityExprEqual0R2, 
@@ -1206,7 +1316,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_EqualityExprEqual, 0, 3, 
 		symbols.Symbols{  
 			symbols.NT_EqualityExpr, 
-			symbols.T_15, 
+			symbols.T_16, 
 			symbols.NT_RelationalExpr,
 		}, 
 		EqualityExprEqual0R3, 
@@ -1247,6 +1357,140 @@ var slots = map[Label]*Slot{
 		}, 
 		EqualityExprNotEqual0R3, 
 	},
+	Expr0R0: {
+		symbols.NT_Expr, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_ExprSingle,
+		}, 
+		Expr0R0, 
+	},
+	Expr0R1: {
+		symbols.NT_Expr, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_ExprSingle,
+		}, 
+		Expr0R1, 
+	},
+	Expr1R0: {
+		symbols.NT_Expr, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_ExprComma,
+		}, 
+		Expr1R0, 
+	},
+	Expr1R1: {
+		symbols.NT_Expr, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_ExprComma,
+		}, 
+		Expr1R1, 
+	},
+	ExprComma0R0: {
+		symbols.NT_ExprComma, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_ExprSingle, 
+			symbols.T_5, 
+			symbols.NT_Expr,
+		}, 
+		ExprComma0R0, 
+	},
+	ExprComma0R1: {
+		symbols.NT_ExprComma, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_ExprSingle, 
+			symbols.T_5, 
+			symbols.NT_Expr,
+		}, 
+		ExprComma0R1, 
+	},
+	ExprComma0R2: {
+		symbols.NT_ExprComma, 0, 2, 
+		symbols.Symbols{  
+			symbols.NT_ExprSingle, 
+			symbols.T_5, 
+			symbols.NT_Expr,
+		}, 
+		ExprComma0R2, 
+	},
+	ExprComma0R3: {
+		symbols.NT_ExprComma, 0, 3, 
+		symbols.Symbols{  
+			symbols.NT_ExprSingle, 
+			symbols.T_5, 
+			symbols.NT_Expr,
+		}, 
+		ExprComma0R3, 
+	},
+	ExprSingle0R0: {
+		symbols.NT_ExprSingle, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_OrExpr,
+		}, 
+		ExprSingle0R0, 
+	},
+	ExprSingle0R1: {
+		symbols.NT_ExprSingle, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_OrExpr,
+		}, 
+		ExprSingle0R1, 
+	},
+	ExprSingle1R0: {
+		symbols.NT_ExprSingle, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_IfExpr,
+		}, 
+		ExprSingle1R0, 
+	},
+	ExprSingle1R1: {
+		symbols.NT_ExprSingle, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_IfExpr,
+		}, 
+		ExprSingle1R1, 
+	},
+	ExprSingle2R0: {
+		symbols.NT_ExprSingle, 2, 0, 
+		symbols.Symbols{  
+			symbols.NT_LetExpr,
+		}, 
+		ExprSingle2R0, 
+	},
+	ExprSingle2R1: {
+		symbols.NT_ExprSingle, 2, 1, 
+		symbols.Symbols{  
+			symbols.NT_LetExpr,
+		}, 
+		ExprSingle2R1, 
+	},
+	ExprSingle3R0: {
+		symbols.NT_ExprSingle, 3, 0, 
+		symbols.Symbols{  
+			symbols.NT_ForExpr,
+		}, 
+		ExprSingle3R0, 
+	},
+	ExprSingle3R1: {
+		symbols.NT_ExprSingle, 3, 1, 
+		symbols.Symbols{  
+			symbols.NT_ForExpr,
+		}, 
+		ExprSingle3R1, 
+	},
+	ExprSingle4R0: {
+		symbols.NT_ExprSingle, 4, 0, 
+		symbols.Symbols{  
+			symbols.NT_QuantifiedExpr,
+		}, 
+		ExprSingle4R0, 
+	},
+	ExprSingle4R1: {
+		symbols.NT_ExprSingle, 4, 1, 
+		symbols.Symbols{  
+			symbols.NT_QuantifiedExpr,
+		}, 
+		ExprSingle4R1, 
+	},
 	FilterExpr0R0: {
 		symbols.NT_FilterExpr, 0, 0, 
 		symbols.Symbols{  
@@ -1299,6 +1543,90 @@ var slots = map[Label]*Slot{
 		}, 
 		FilterExprWithPredicate0R2, 
 	},
+	ForExpr0R0: {
+		symbols.NT_ForExpr, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_37, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		ForExpr0R0, 
+	},
+	ForExpr0R1: {
+		symbols.NT_ForExpr, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_37, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		ForExpr0R1, 
+	},
+	ForExpr0R2: {
+		symbols.NT_ForExpr, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_37, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		ForExpr0R2, 
+	},
+	ForExpr0R3: {
+		symbols.NT_ForExpr, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_37, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		ForExpr0R3, 
+	},
+	ForExpr0R4: {
+		symbols.NT_ForExpr, 0, 4, 
+		symbols.Symbols{  
+			symbols.T_37, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		ForExpr0R4, 
+	},
+	ForExpr0R5: {
+		symbols.NT_ForExpr, 0, 5, 
+		symbols.Symbols{  
+			symbols.T_37, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		ForExpr0R5, 
+	},
+	ForExpr0R6: {
+		symbols.NT_ForExpr, 0, 6, 
+		symbols.Symbols{  
+			symbols.T_37, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		ForExpr0R6, 
+	},
 	FunctionCall0R0: {
 		symbols.NT_FunctionCall, 0, 0, 
 		symbols.Symbols{  
@@ -1366,7 +1694,7 @@ var slots = map[Label]*Slot{
 	FunctionCallArgumentListArgWithNext0R0: {
 		symbols.NT_FunctionCallArgumentListArgWithNext, 0, 0, 
 		symbols.Symbols{  
-			symbols.NT_OrExpr, 
+			symbols.NT_ExprSingle, 
 			symbols.T_5, 
 			symbols.NT_FunctionCallArgumentList,
 		}, 
@@ -1375,7 +1703,7 @@ var slots = map[Label]*Slot{
 	FunctionCallArgumentListArgWithNext0R1: {
 		symbols.NT_FunctionCallArgumentListArgWithNext, 0, 1, 
 		symbols.Symbols{  
-			symbols.NT_OrExpr, 
+			symbols.NT_ExprSingle, 
 			symbols.T_5, 
 			symbols.NT_FunctionCallArgumentList,
 		}, 
@@ -1384,7 +1712,7 @@ var slots = map[Label]*Slot{
 	FunctionCallArgumentListArgWithNext0R2: {
 		symbols.NT_FunctionCallArgumentListArgWithNext, 0, 2, 
 		symbols.Symbols{  
-			symbols.NT_OrExpr, 
+			symbols.NT_ExprSingle, 
 			symbols.T_5, 
 			symbols.NT_FunctionCallArgumentList,
 		}, 
@@ -1393,7 +1721,7 @@ var slots = map[Label]*Slot{
 	FunctionCallArgumentListArgWithNext0R3: {
 		symbols.NT_FunctionCallArgumentListArgWithNext, 0, 3, 
 		symbols.Symbols{  
-			symbols.NT_OrExpr, 
+			symbols.NT_ExprSingle, 
 			symbols.T_5, 
 			symbols.NT_FunctionCallArgumentList,
 		}, 
@@ -1402,7 +1730,7 @@ var slots = map[Label]*Slot{
 	FunctionCallArgumentListEndArg0R0: {
 		symbols.NT_FunctionCallArgumentListEndArg, 0, 0, 
 		symbols.Symbols{  
-			symbols.NT_OrExpr, 
+			symbols.NT_ExprSingle, 
 			symbols.T_2,
 		}, 
 		FunctionCallArgumentListEndArg0R0, 
@@ -1410,7 +1738,7 @@ var slots = map[Label]*Slot{
 	FunctionCallArgumentListEndArg0R1: {
 		symbols.NT_FunctionCallArgumentListEndArg, 0, 1, 
 		symbols.Symbols{  
-			symbols.NT_OrExpr, 
+			symbols.NT_ExprSingle, 
 			symbols.T_2,
 		}, 
 		FunctionCallArgumentListEndArg0R1, 
@@ -1418,7 +1746,7 @@ var slots = map[Label]*Slot{
 	FunctionCallArgumentListEndArg0R2: {
 		symbols.NT_FunctionCallArgumentListEndArg, 0, 2, 
 		symbols.Symbols{  
-			symbols.NT_OrExpr, 
+			symbols.NT_ExprSingle, 
 			symbols.T_2,
 		}, 
 		FunctionCallArgumentListEndArg0R2, 
@@ -1465,31 +1793,241 @@ var slots = map[Label]*Slot{
 		}, 
 		FunctionSignatureNoArgs0R1, 
 	},
+	IfExpr0R0: {
+		symbols.NT_IfExpr, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_38, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.T_56, 
+			symbols.NT_ExprSingle, 
+			symbols.T_33, 
+			symbols.NT_ExprSingle,
+		}, 
+		IfExpr0R0, 
+	},
+	IfExpr0R1: {
+		symbols.NT_IfExpr, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_38, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.T_56, 
+			symbols.NT_ExprSingle, 
+			symbols.T_33, 
+			symbols.NT_ExprSingle,
+		}, 
+		IfExpr0R1, 
+	},
+	IfExpr0R2: {
+		symbols.NT_IfExpr, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_38, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.T_56, 
+			symbols.NT_ExprSingle, 
+			symbols.T_33, 
+			symbols.NT_ExprSingle,
+		}, 
+		IfExpr0R2, 
+	},
+	IfExpr0R3: {
+		symbols.NT_IfExpr, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_38, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.T_56, 
+			symbols.NT_ExprSingle, 
+			symbols.T_33, 
+			symbols.NT_ExprSingle,
+		}, 
+		IfExpr0R3, 
+	},
+	IfExpr0R4: {
+		symbols.NT_IfExpr, 0, 4, 
+		symbols.Symbols{  
+			symbols.T_38, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.T_56, 
+			symbols.NT_ExprSingle, 
+			symbols.T_33, 
+			symbols.NT_ExprSingle,
+		}, 
+		IfExpr0R4, 
+	},
+	IfExpr0R5: {
+		symbols.NT_IfExpr, 0, 5, 
+		symbols.Symbols{  
+			symbols.T_38, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.T_56, 
+			symbols.NT_ExprSingle, 
+			symbols.T_33, 
+			symbols.NT_ExprSingle,
+		}, 
+		IfExpr0R5, 
+	},
+	IfExpr0R6: {
+		symbols.NT_IfExpr, 0, 6, 
+		symbols.Symbols{  
+			symbols.T_38, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.T_56, 
+			symbols.NT_ExprSingle, 
+			symbols.T_33, 
+			symbols.NT_ExprSingle,
+		}, 
+		IfExpr0R6, 
+	},
+	IfExpr0R7: {
+		symbols.NT_IfExpr, 0, 7, 
+		symbols.Symbols{  
+			symbols.T_38, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.T_56, 
+			symbols.NT_ExprSingle, 
+			symbols.T_33, 
+			symbols.NT_ExprSingle,
+		}, 
+		IfExpr0R7, 
+	},
+	IfExpr0R8: {
+		symbols.NT_IfExpr, 0, 8, 
+		symbols.Symbols{  
+			symbols.T_38, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2, 
+			symbols.T_56, 
+			symbols.NT_ExprSingle, 
+			symbols.T_33, 
+			symbols.NT_ExprSingle,
+		}, 
+		IfExpr0R8, 
+	},
+	LetExpr0R0: {
+		symbols.NT_LetExpr, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_40, 
+			symbols.NT_VariableReference, 
+			symbols.T_13, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		LetExpr0R0, 
+	},
+	LetExpr0R1: {
+		symbols.NT_LetExpr, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_40, 
+			symbols.NT_VariableReference, 
+			symbols.T_13, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		LetExpr0R1, 
+	},
+	LetExpr0R2: {
+		symbols.NT_LetExpr, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_40, 
+			symbols.NT_VariableReference, 
+			symbols.T_13, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		LetExpr0R2, 
+	},
+	LetExpr0R3: {
+		symbols.NT_LetExpr, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_40, 
+			symbols.NT_VariableReference, 
+			symbols.T_13, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		LetExpr0R3, 
+	},
+	LetExpr0R4: {
+		symbols.NT_LetExpr, 0, 4, 
+		symbols.Symbols{  
+			symbols.T_40, 
+			symbols.NT_VariableReference, 
+			symbols.T_13, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		LetExpr0R4, 
+	},
+	LetExpr0R5: {
+		symbols.NT_LetExpr, 0, 5, 
+		symbols.Symbols{  
+			symbols.T_40, 
+			symbols.NT_VariableReference, 
+			symbols.T_13, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		LetExpr0R5, 
+	},
+	LetExpr0R6: {
+		symbols.NT_LetExpr, 0, 6, 
+		symbols.Symbols{  
+			symbols.T_40, 
+			symbols.NT_VariableReference, 
+			symbols.T_13, 
+			symbols.NT_ExprSingle, 
+			symbols.T_50, 
+			symbols.NT_ExprSingle,
+		}, 
+		LetExpr0R6, 
+	},
 	Literal0R0: {
 		symbols.NT_Literal, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_44,
+			symbols.T_53,
 		}, 
 		Literal0R0, 
 	},
 	Literal0R1: {
 		symbols.NT_Literal, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_44,
+			symbols.T_53,
 		}, 
 		Literal0R1, 
 	},
 	Literal1R0: {
 		symbols.NT_Literal, 1, 0, 
 		symbols.Symbols{  
-			symbols.T_31,
+			symbols.T_32,
 		}, 
 		Literal1R0, 
 	},
 	Literal1R1: {
 		symbols.NT_Literal, 1, 1, 
 		symbols.Symbols{  
-			symbols.T_31,
+			symbols.T_32,
 		}, 
 		Literal1R1, 
 	},
@@ -1581,7 +2119,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_MultiplicativeExprDivide, 0, 0, 
 		symbols.Symbols{  
 			symbols.NT_MultiplicativeExpr, 
-			symbols.T_30, 
+			symbols.T_31, 
 			symbols.NT_UnaryExpr,
 		}, 
 		MultiplicativeExprDivide0R0, 
@@ -1590,7 +2128,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_MultiplicativeExprDivide, 0, 1, 
 		symbols.Symbols{  
 			symbols.NT_MultiplicativeExpr, 
-			symbols.T_30, 
+			symbols.T_31, 
 			symbols.NT_UnaryExpr,
 		}, 
 		MultiplicativeExprDivide0R1, 
@@ -1599,7 +2137,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_MultiplicativeExprDivide, 0, 2, 
 		symbols.Symbols{  
 			symbols.NT_MultiplicativeExpr, 
-			symbols.T_30, 
+			symbols.T_31, 
 			symbols.NT_UnaryExpr,
 		}, 
 		MultiplicativeExprDivide0R2, 
@@ -1608,7 +2146,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_MultiplicativeExprDivide, 0, 3, 
 		symbols.Symbols{  
 			symbols.NT_MultiplicativeExpr, 
-			symbols.T_30, 
+			symbols.T_31, 
 			symbols.NT_UnaryExpr,
 		}, 
 		MultiplicativeExprDivide0R3, 
@@ -1617,7 +2155,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_MultiplicativeExprMod, 0, 0, 
 		symbols.Symbols{  
 			symbols.NT_MultiplicativeExpr, 
-			symbols.T_34, 
+			symbols.T_41, 
 			symbols.NT_UnaryExpr,
 		}, 
 		MultiplicativeExprMod0R0, 
@@ -1626,7 +2164,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_MultiplicativeExprMod, 0, 1, 
 		symbols.Symbols{  
 			symbols.NT_MultiplicativeExpr, 
-			symbols.T_34, 
+			symbols.T_41, 
 			symbols.NT_UnaryExpr,
 		}, 
 		MultiplicativeExprMod0R1, 
@@ -1635,7 +2173,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_MultiplicativeExprMod, 0, 2, 
 		symbols.Symbols{  
 			symbols.NT_MultiplicativeExpr, 
-			symbols.T_34, 
+			symbols.T_41, 
 			symbols.NT_UnaryExpr,
 		}, 
 		MultiplicativeExprMod0R2, 
@@ -1644,7 +2182,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_MultiplicativeExprMod, 0, 3, 
 		symbols.Symbols{  
 			symbols.NT_MultiplicativeExpr, 
-			symbols.T_34, 
+			symbols.T_41, 
 			symbols.NT_UnaryExpr,
 		}, 
 		MultiplicativeExprMod0R3, 
@@ -1704,7 +2242,7 @@ var slots = map[Label]*Slot{
 		symbols.Symbols{  
 			symbols.T_3, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestLocalAnyNamespace0R0, 
 	},
@@ -1713,7 +2251,7 @@ var slots = map[Label]*Slot{
 		symbols.Symbols{  
 			symbols.T_3, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestLocalAnyNamespace0R1, 
 	},
@@ -1722,7 +2260,7 @@ var slots = map[Label]*Slot{
 		symbols.Symbols{  
 			symbols.T_3, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestLocalAnyNamespace0R2, 
 	},
@@ -1731,7 +2269,7 @@ var slots = map[Label]*Slot{
 		symbols.Symbols{  
 			symbols.T_3, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestLocalAnyNamespace0R3, 
 	},
@@ -1774,7 +2312,7 @@ var slots = map[Label]*Slot{
 	NameTestNamespaceAnyLocal0R0: {
 		symbols.NT_NameTestNamespaceAnyLocal, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
 			symbols.T_3,
 		}, 
@@ -1783,7 +2321,7 @@ var slots = map[Label]*Slot{
 	NameTestNamespaceAnyLocal0R1: {
 		symbols.NT_NameTestNamespaceAnyLocal, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
 			symbols.T_3,
 		}, 
@@ -1792,7 +2330,7 @@ var slots = map[Label]*Slot{
 	NameTestNamespaceAnyLocal0R2: {
 		symbols.NT_NameTestNamespaceAnyLocal, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
 			symbols.T_3,
 		}, 
@@ -1801,7 +2339,7 @@ var slots = map[Label]*Slot{
 	NameTestNamespaceAnyLocal0R3: {
 		symbols.NT_NameTestNamespaceAnyLocal, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
 			symbols.T_3,
 		}, 
@@ -1846,14 +2384,14 @@ var slots = map[Label]*Slot{
 	NameTestQNameLocalOnly0R0: {
 		symbols.NT_NameTestQNameLocalOnly, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestQNameLocalOnly0R0, 
 	},
 	NameTestQNameLocalOnly0R1: {
 		symbols.NT_NameTestQNameLocalOnly, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestQNameLocalOnly0R1, 
 	},
@@ -1874,36 +2412,36 @@ var slots = map[Label]*Slot{
 	NameTestQNameNamespaceWithLocal0R0: {
 		symbols.NT_NameTestQNameNamespaceWithLocal, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestQNameNamespaceWithLocal0R0, 
 	},
 	NameTestQNameNamespaceWithLocal0R1: {
 		symbols.NT_NameTestQNameNamespaceWithLocal, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestQNameNamespaceWithLocal0R1, 
 	},
 	NameTestQNameNamespaceWithLocal0R2: {
 		symbols.NT_NameTestQNameNamespaceWithLocal, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestQNameNamespaceWithLocal0R2, 
 	},
 	NameTestQNameNamespaceWithLocal0R3: {
 		symbols.NT_NameTestQNameNamespaceWithLocal, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestQNameNamespaceWithLocal0R3, 
 	},
@@ -1946,7 +2484,7 @@ var slots = map[Label]*Slot{
 	NameTestQNameNamespaceWithLocalReservedNameConflictLocal0R0: {
 		symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictLocal, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
 			symbols.NT_ReservedNameConflictResolver,
 		}, 
@@ -1955,7 +2493,7 @@ var slots = map[Label]*Slot{
 	NameTestQNameNamespaceWithLocalReservedNameConflictLocal0R1: {
 		symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictLocal, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
 			symbols.NT_ReservedNameConflictResolver,
 		}, 
@@ -1964,7 +2502,7 @@ var slots = map[Label]*Slot{
 	NameTestQNameNamespaceWithLocalReservedNameConflictLocal0R2: {
 		symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictLocal, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
 			symbols.NT_ReservedNameConflictResolver,
 		}, 
@@ -1973,7 +2511,7 @@ var slots = map[Label]*Slot{
 	NameTestQNameNamespaceWithLocalReservedNameConflictLocal0R3: {
 		symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictLocal, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
 			symbols.NT_ReservedNameConflictResolver,
 		}, 
@@ -1984,7 +2522,7 @@ var slots = map[Label]*Slot{
 		symbols.Symbols{  
 			symbols.NT_ReservedNameConflictResolver, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestQNameNamespaceWithLocalReservedNameConflictNamespace0R0, 
 	},
@@ -1993,7 +2531,7 @@ var slots = map[Label]*Slot{
 		symbols.Symbols{  
 			symbols.NT_ReservedNameConflictResolver, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestQNameNamespaceWithLocalReservedNameConflictNamespace0R1, 
 	},
@@ -2002,7 +2540,7 @@ var slots = map[Label]*Slot{
 		symbols.Symbols{  
 			symbols.NT_ReservedNameConflictResolver, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestQNameNamespaceWithLocalReservedNameConflictNamespace0R2, 
 	},
@@ -2011,7 +2549,7 @@ var slots = map[Label]*Slot{
 		symbols.Symbols{  
 			symbols.NT_ReservedNameConflictResolver, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		NameTestQNameNamespaceWithLocalReservedNameConflictNamespace0R3, 
 	},
@@ -2260,7 +2798,7 @@ var slots = map[Label]*Slot{
 	NodeTestProcInstTargetTest0R0: {
 		symbols.NT_NodeTestProcInstTargetTest, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_42, 
+			symbols.T_49, 
 			symbols.T_1, 
 			symbols.NT_Literal, 
 			symbols.T_2,
@@ -2270,7 +2808,7 @@ var slots = map[Label]*Slot{
 	NodeTestProcInstTargetTest0R1: {
 		symbols.NT_NodeTestProcInstTargetTest, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_42, 
+			symbols.T_49, 
 			symbols.T_1, 
 			symbols.NT_Literal, 
 			symbols.T_2,
@@ -2280,7 +2818,7 @@ var slots = map[Label]*Slot{
 	NodeTestProcInstTargetTest0R2: {
 		symbols.NT_NodeTestProcInstTargetTest, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_42, 
+			symbols.T_49, 
 			symbols.T_1, 
 			symbols.NT_Literal, 
 			symbols.T_2,
@@ -2290,7 +2828,7 @@ var slots = map[Label]*Slot{
 	NodeTestProcInstTargetTest0R3: {
 		symbols.NT_NodeTestProcInstTargetTest, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_42, 
+			symbols.T_49, 
 			symbols.T_1, 
 			symbols.NT_Literal, 
 			symbols.T_2,
@@ -2300,7 +2838,7 @@ var slots = map[Label]*Slot{
 	NodeTestProcInstTargetTest0R4: {
 		symbols.NT_NodeTestProcInstTargetTest, 0, 4, 
 		symbols.Symbols{  
-			symbols.T_42, 
+			symbols.T_49, 
 			symbols.T_1, 
 			symbols.NT_Literal, 
 			symbols.T_2,
@@ -2310,70 +2848,70 @@ var slots = map[Label]*Slot{
 	NodeType0R0: {
 		symbols.NT_NodeType, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_26,
+			symbols.T_27,
 		}, 
 		NodeType0R0, 
 	},
 	NodeType0R1: {
 		symbols.NT_NodeType, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_26,
+			symbols.T_27,
 		}, 
 		NodeType0R1, 
 	},
 	NodeType1R0: {
 		symbols.NT_NodeType, 1, 0, 
 		symbols.Symbols{  
-			symbols.T_45,
+			symbols.T_55,
 		}, 
 		NodeType1R0, 
 	},
 	NodeType1R1: {
 		symbols.NT_NodeType, 1, 1, 
 		symbols.Symbols{  
-			symbols.T_45,
+			symbols.T_55,
 		}, 
 		NodeType1R1, 
 	},
 	NodeType2R0: {
 		symbols.NT_NodeType, 2, 0, 
 		symbols.Symbols{  
-			symbols.T_42,
+			symbols.T_49,
 		}, 
 		NodeType2R0, 
 	},
 	NodeType2R1: {
 		symbols.NT_NodeType, 2, 1, 
 		symbols.Symbols{  
-			symbols.T_42,
+			symbols.T_49,
 		}, 
 		NodeType2R1, 
 	},
 	NodeType3R0: {
 		symbols.NT_NodeType, 3, 0, 
 		symbols.Symbols{  
-			symbols.T_37,
+			symbols.T_44,
 		}, 
 		NodeType3R0, 
 	},
 	NodeType3R1: {
 		symbols.NT_NodeType, 3, 1, 
 		symbols.Symbols{  
-			symbols.T_37,
+			symbols.T_44,
 		}, 
 		NodeType3R1, 
 	},
 	Number0R0: {
 		symbols.NT_Number, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_29,
+			symbols.T_30,
 		}, 
 		Number0R0, 
 	},
 	Number0R1: {
 		symbols.NT_Number, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_29,
+			symbols.T_30,
 		}, 
 		Number0R1, 
 	},
@@ -2381,7 +2919,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_Number, 1, 0, 
 		symbols.Symbols{  
 			symbols.T_7, 
-			symbols.T_29,
+			symbols.T_30,
 		}, 
 		Number1R0, 
 	},
@@ -2389,7 +2927,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_Number, 1, 1, 
 		symbols.Symbols{  
 			symbols.T_7, 
-			symbols.T_29,
+			symbols.T_30,
 		}, 
 		Number1R1, 
 	},
@@ -2397,43 +2935,43 @@ var slots = map[Label]*Slot{
 		symbols.NT_Number, 1, 2, 
 		symbols.Symbols{  
 			symbols.T_7, 
-			symbols.T_29,
+			symbols.T_30,
 		}, 
 		Number1R2, 
 	},
 	Number2R0: {
 		symbols.NT_Number, 2, 0, 
 		symbols.Symbols{  
-			symbols.T_29, 
+			symbols.T_30, 
 			symbols.T_7, 
-			symbols.T_29,
+			symbols.T_30,
 		}, 
 		Number2R0, 
 	},
 	Number2R1: {
 		symbols.NT_Number, 2, 1, 
 		symbols.Symbols{  
-			symbols.T_29, 
+			symbols.T_30, 
 			symbols.T_7, 
-			symbols.T_29,
+			symbols.T_30,
 		}, 
 		Number2R1, 
 	},
 	Number2R2: {
 		symbols.NT_Number, 2, 2, 
 		symbols.Symbols{  
-			symbols.T_29, 
+			symbols.T_30, 
 			symbols.T_7, 
-			symbols.T_29,
+			symbols.T_30,
 		}, 
 		Number2R2, 
 	},
 	Number2R3: {
 		symbols.NT_Number, 2, 3, 
 		symbols.Symbols{  
-			symbols.T_29, 
+			symbols.T_30, 
 			symbols.T_7, 
-			symbols.T_29,
+			symbols.T_30,
 		}, 
 		Number2R3, 
 	},
@@ -2469,7 +3007,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_OrExprOr, 0, 0, 
 		symbols.Symbols{  
 			symbols.NT_OrExpr, 
-			symbols.T_38, 
+			symbols.T_45, 
 			symbols.NT_AndExpr,
 		}, 
 		OrExprOr0R0, 
@@ -2478,7 +3016,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_OrExprOr, 0, 1, 
 		symbols.Symbols{  
 			symbols.NT_OrExpr, 
-			symbols.T_38, 
+			symbols.T_45, 
 			symbols.NT_AndExpr,
 		}, 
 		OrExprOr0R1, 
@@ -2487,7 +3025,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_OrExprOr, 0, 2, 
 		symbols.Symbols{  
 			symbols.NT_OrExpr, 
-			symbols.T_38, 
+			symbols.T_45, 
 			symbols.NT_AndExpr,
 		}, 
 		OrExprOr0R2, 
@@ -2496,7 +3034,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_OrExprOr, 0, 3, 
 		symbols.Symbols{  
 			symbols.NT_OrExpr, 
-			symbols.T_38, 
+			symbols.T_45, 
 			symbols.NT_AndExpr,
 		}, 
 		OrExprOr0R3, 
@@ -2557,6 +3095,20 @@ var slots = map[Label]*Slot{
 		}, 
 		PathExpr3R1, 
 	},
+	PathExpr4R0: {
+		symbols.NT_PathExpr, 4, 0, 
+		symbols.Symbols{  
+			symbols.NT_PathExprFilterWithExprStep,
+		}, 
+		PathExpr4R0, 
+	},
+	PathExpr4R1: {
+		symbols.NT_PathExpr, 4, 1, 
+		symbols.Symbols{  
+			symbols.NT_PathExprFilterWithExprStep,
+		}, 
+		PathExpr4R1, 
+	},
 	PathExprFilterWithAbbreviatedPath0R0: {
 		symbols.NT_PathExprFilterWithAbbreviatedPath, 0, 0, 
 		symbols.Symbols{  
@@ -2593,6 +3145,72 @@ var slots = map[Label]*Slot{
 		}, 
 		PathExprFilterWithAbbreviatedPath0R3, 
 	},
+	PathExprFilterWithExprStep0R0: {
+		symbols.NT_PathExprFilterWithExprStep, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_FilterExpr, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		PathExprFilterWithExprStep0R0, 
+	},
+	PathExprFilterWithExprStep0R1: {
+		symbols.NT_PathExprFilterWithExprStep, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_FilterExpr, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		PathExprFilterWithExprStep0R1, 
+	},
+	PathExprFilterWithExprStep0R2: {
+		symbols.NT_PathExprFilterWithExprStep, 0, 2, 
+		symbols.Symbols{  
+			symbols.NT_FilterExpr, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		PathExprFilterWithExprStep0R2, 
+	},
+	PathExprFilterWithExprStep0R3: {
+		symbols.NT_PathExprFilterWithExprStep, 0, 3, 
+		symbols.Symbols{  
+			symbols.NT_FilterExpr, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		PathExprFilterWithExprStep0R3, 
+	},
+	PathExprFilterWithExprStep0R4: {
+		symbols.NT_PathExprFilterWithExprStep, 0, 4, 
+		symbols.Symbols{  
+			symbols.NT_FilterExpr, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		PathExprFilterWithExprStep0R4, 
+	},
+	PathExprFilterWithExprStep0R5: {
+		symbols.NT_PathExprFilterWithExprStep, 0, 5, 
+		symbols.Symbols{  
+			symbols.NT_FilterExpr, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		PathExprFilterWithExprStep0R5, 
+	},
 	PathExprFilterWithPath0R0: {
 		symbols.NT_PathExprFilterWithPath, 0, 0, 
 		symbols.Symbols{  
@@ -2632,36 +3250,36 @@ var slots = map[Label]*Slot{
 	Predicate0R0: {
 		symbols.NT_Predicate, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_19, 
-			symbols.NT_OrExpr, 
-			symbols.T_20,
+			symbols.T_20, 
+			symbols.NT_Expr, 
+			symbols.T_21,
 		}, 
 		Predicate0R0, 
 	},
 	Predicate0R1: {
 		symbols.NT_Predicate, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_19, 
-			symbols.NT_OrExpr, 
-			symbols.T_20,
+			symbols.T_20, 
+			symbols.NT_Expr, 
+			symbols.T_21,
 		}, 
 		Predicate0R1, 
 	},
 	Predicate0R2: {
 		symbols.NT_Predicate, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_19, 
-			symbols.NT_OrExpr, 
-			symbols.T_20,
+			symbols.T_20, 
+			symbols.NT_Expr, 
+			symbols.T_21,
 		}, 
 		Predicate0R2, 
 	},
 	Predicate0R3: {
 		symbols.NT_Predicate, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_19, 
-			symbols.NT_OrExpr, 
-			symbols.T_20,
+			symbols.T_20, 
+			symbols.NT_Expr, 
+			symbols.T_21,
 		}, 
 		Predicate0R3, 
 	},
@@ -2682,64 +3300,102 @@ var slots = map[Label]*Slot{
 	PrimaryExpr1R0: {
 		symbols.NT_PrimaryExpr, 1, 0, 
 		symbols.Symbols{  
-			symbols.NT_Literal,
+			symbols.NT_PrimaryExprEmptySequence,
 		}, 
 		PrimaryExpr1R0, 
 	},
 	PrimaryExpr1R1: {
 		symbols.NT_PrimaryExpr, 1, 1, 
 		symbols.Symbols{  
-			symbols.NT_Literal,
+			symbols.NT_PrimaryExprEmptySequence,
 		}, 
 		PrimaryExpr1R1, 
 	},
 	PrimaryExpr2R0: {
 		symbols.NT_PrimaryExpr, 2, 0, 
 		symbols.Symbols{  
-			symbols.NT_Number,
+			symbols.NT_Literal,
 		}, 
 		PrimaryExpr2R0, 
 	},
 	PrimaryExpr2R1: {
 		symbols.NT_PrimaryExpr, 2, 1, 
 		symbols.Symbols{  
-			symbols.NT_Number,
+			symbols.NT_Literal,
 		}, 
 		PrimaryExpr2R1, 
 	},
 	PrimaryExpr3R0: {
 		symbols.NT_PrimaryExpr, 3, 0, 
 		symbols.Symbols{  
-			symbols.NT_VariableReference,
+			symbols.NT_Number,
 		}, 
 		PrimaryExpr3R0, 
 	},
 	PrimaryExpr3R1: {
 		symbols.NT_PrimaryExpr, 3, 1, 
 		symbols.Symbols{  
-			symbols.NT_VariableReference,
+			symbols.NT_Number,
 		}, 
 		PrimaryExpr3R1, 
 	},
 	PrimaryExpr4R0: {
 		symbols.NT_PrimaryExpr, 4, 0, 
 		symbols.Symbols{  
-			symbols.NT_FunctionCall,
+			symbols.NT_VariableReference,
 		}, 
 		PrimaryExpr4R0, 
 	},
 	PrimaryExpr4R1: {
 		symbols.NT_PrimaryExpr, 4, 1, 
 		symbols.Symbols{  
-			symbols.NT_FunctionCall,
+			symbols.NT_VariableReference,
 		}, 
 		PrimaryExpr4R1, 
 	},
+	PrimaryExpr5R0: {
+		symbols.NT_PrimaryExpr, 5, 0, 
+		symbols.Symbols{  
+			symbols.NT_FunctionCall,
+		}, 
+		PrimaryExpr5R0, 
+	},
+	PrimaryExpr5R1: {
+		symbols.NT_PrimaryExpr, 5, 1, 
+		symbols.Symbols{  
+			symbols.NT_FunctionCall,
+		}, 
+		PrimaryExpr5R1, 
+	},
+	PrimaryExprEmptySequence0R0: {
+		symbols.NT_PrimaryExprEmptySequence, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_1, 
+			symbols.T_2,
+		}, 
+		PrimaryExprEmptySequence0R0, 
+	},
+	PrimaryExprEmptySequence0R1: {
+		symbols.NT_PrimaryExprEmptySequence, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_1, 
+			symbols.T_2,
+		}, 
+		PrimaryExprEmptySequence0R1, 
+	},
+	PrimaryExprEmptySequence0R2: {
+		symbols.NT_PrimaryExprEmptySequence, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_1, 
+			symbols.T_2,
+		}, 
+		PrimaryExprEmptySequence0R2, 
+	},
 	PrimaryExprParenthetic0R0: {
 		symbols.NT_PrimaryExprParenthetic, 0, 0, 
 		symbols.Symbols{  
 			symbols.T_1, 
-			symbols.NT_OrExpr, 
+			symbols.NT_Expr, 
 			symbols.T_2,
 		}, 
 		PrimaryExprParenthetic0R0, 
@@ -2748,7 +3404,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_PrimaryExprParenthetic, 0, 1, 
 		symbols.Symbols{  
 			symbols.T_1, 
-			symbols.NT_OrExpr, 
+			symbols.NT_Expr, 
 			symbols.T_2,
 		}, 
 		PrimaryExprParenthetic0R1, 
@@ -2757,7 +3413,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_PrimaryExprParenthetic, 0, 2, 
 		symbols.Symbols{  
 			symbols.T_1, 
-			symbols.NT_OrExpr, 
+			symbols.NT_Expr, 
 			symbols.T_2,
 		}, 
 		PrimaryExprParenthetic0R2, 
@@ -2766,7 +3422,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_PrimaryExprParenthetic, 0, 3, 
 		symbols.Symbols{  
 			symbols.T_1, 
-			symbols.NT_OrExpr, 
+			symbols.NT_Expr, 
 			symbols.T_2,
 		}, 
 		PrimaryExprParenthetic0R3, 
@@ -2802,64 +3458,324 @@ var slots = map[Label]*Slot{
 	QNameLocalOnly0R0: {
 		symbols.NT_QNameLocalOnly, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		QNameLocalOnly0R0, 
 	},
 	QNameLocalOnly0R1: {
 		symbols.NT_QNameLocalOnly, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		QNameLocalOnly0R1, 
 	},
 	QNameNamespaceWithLocal0R0: {
 		symbols.NT_QNameNamespaceWithLocal, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		QNameNamespaceWithLocal0R0, 
 	},
 	QNameNamespaceWithLocal0R1: {
 		symbols.NT_QNameNamespaceWithLocal, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		QNameNamespaceWithLocal0R1, 
 	},
 	QNameNamespaceWithLocal0R2: {
 		symbols.NT_QNameNamespaceWithLocal, 0, 2, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		QNameNamespaceWithLocal0R2, 
 	},
 	QNameNamespaceWithLocal0R3: {
 		symbols.NT_QNameNamespaceWithLocal, 0, 3, 
 		symbols.Symbols{  
-			symbols.T_36, 
+			symbols.T_43, 
 			symbols.T_11, 
-			symbols.T_36,
+			symbols.T_43,
 		}, 
 		QNameNamespaceWithLocal0R3, 
 	},
+	QuantifiedExpr0R0: {
+		symbols.NT_QuantifiedExpr, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_QuantifiedExprSome,
+		}, 
+		QuantifiedExpr0R0, 
+	},
+	QuantifiedExpr0R1: {
+		symbols.NT_QuantifiedExpr, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_QuantifiedExprSome,
+		}, 
+		QuantifiedExpr0R1, 
+	},
+	QuantifiedExpr1R0: {
+		symbols.NT_QuantifiedExpr, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_QuantifiedExprEvery,
+		}, 
+		QuantifiedExpr1R0, 
+	},
+	QuantifiedExpr1R1: {
+		symbols.NT_QuantifiedExpr, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_QuantifiedExprEvery,
+		}, 
+		QuantifiedExpr1R1, 
+	},
+	QuantifiedExprEvery0R0: {
+		symbols.NT_QuantifiedExprEvery, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_34, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprEvery0R0, 
+	},
+	QuantifiedExprEvery0R1: {
+		symbols.NT_QuantifiedExprEvery, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_34, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprEvery0R1, 
+	},
+	QuantifiedExprEvery0R2: {
+		symbols.NT_QuantifiedExprEvery, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_34, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprEvery0R2, 
+	},
+	QuantifiedExprEvery0R3: {
+		symbols.NT_QuantifiedExprEvery, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_34, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprEvery0R3, 
+	},
+	QuantifiedExprEvery0R4: {
+		symbols.NT_QuantifiedExprEvery, 0, 4, 
+		symbols.Symbols{  
+			symbols.T_34, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprEvery0R4, 
+	},
+	QuantifiedExprEvery0R5: {
+		symbols.NT_QuantifiedExprEvery, 0, 5, 
+		symbols.Symbols{  
+			symbols.T_34, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprEvery0R5, 
+	},
+	QuantifiedExprEvery0R6: {
+		symbols.NT_QuantifiedExprEvery, 0, 6, 
+		symbols.Symbols{  
+			symbols.T_34, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprEvery0R6, 
+	},
+	QuantifiedExprSome0R0: {
+		symbols.NT_QuantifiedExprSome, 0, 0, 
+		symbols.Symbols{  
+			symbols.T_54, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprSome0R0, 
+	},
+	QuantifiedExprSome0R1: {
+		symbols.NT_QuantifiedExprSome, 0, 1, 
+		symbols.Symbols{  
+			symbols.T_54, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprSome0R1, 
+	},
+	QuantifiedExprSome0R2: {
+		symbols.NT_QuantifiedExprSome, 0, 2, 
+		symbols.Symbols{  
+			symbols.T_54, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprSome0R2, 
+	},
+	QuantifiedExprSome0R3: {
+		symbols.NT_QuantifiedExprSome, 0, 3, 
+		symbols.Symbols{  
+			symbols.T_54, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprSome0R3, 
+	},
+	QuantifiedExprSome0R4: {
+		symbols.NT_QuantifiedExprSome, 0, 4, 
+		symbols.Symbols{  
+			symbols.T_54, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprSome0R4, 
+	},
+	QuantifiedExprSome0R5: {
+		symbols.NT_QuantifiedExprSome, 0, 5, 
+		symbols.Symbols{  
+			symbols.T_54, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprSome0R5, 
+	},
+	QuantifiedExprSome0R6: {
+		symbols.NT_QuantifiedExprSome, 0, 6, 
+		symbols.Symbols{  
+			symbols.T_54, 
+			symbols.NT_VariableReference, 
+			symbols.T_39, 
+			symbols.NT_ExprSingle, 
+			symbols.T_51, 
+			symbols.NT_ExprSingle,
+		}, 
+		QuantifiedExprSome0R6, 
+	},
+	RangeExpr0R0: {
+		symbols.NT_RangeExpr, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_AdditiveExpr,
+		}, 
+		RangeExpr0R0, 
+	},
+	RangeExpr0R1: {
+		symbols.NT_RangeExpr, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_AdditiveExpr,
+		}, 
+		RangeExpr0R1, 
+	},
+	RangeExpr1R0: {
+		symbols.NT_RangeExpr, 1, 0, 
+		symbols.Symbols{  
+			symbols.NT_RangeExprTo,
+		}, 
+		RangeExpr1R0, 
+	},
+	RangeExpr1R1: {
+		symbols.NT_RangeExpr, 1, 1, 
+		symbols.Symbols{  
+			symbols.NT_RangeExprTo,
+		}, 
+		RangeExpr1R1, 
+	},
+	RangeExprTo0R0: {
+		symbols.NT_RangeExprTo, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_AdditiveExpr, 
+			symbols.T_57, 
+			symbols.NT_AdditiveExpr,
+		}, 
+		RangeExprTo0R0, 
+	},
+	RangeExprTo0R1: {
+		symbols.NT_RangeExprTo, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_AdditiveExpr, 
+			symbols.T_57, 
+			symbols.NT_AdditiveExpr,
+		}, 
+		RangeExprTo0R1, 
+	},
+	RangeExprTo0R2: {
+		symbols.NT_RangeExprTo, 0, 2, 
+		symbols.Symbols{  
+			symbols.NT_AdditiveExpr, 
+			symbols.T_57, 
+			symbols.NT_AdditiveExpr,
+		}, 
+		RangeExprTo0R2, 
+	},
+	RangeExprTo0R3: {
+		symbols.NT_RangeExprTo, 0, 3, 
+		symbols.Symbols{  
+			symbols.NT_AdditiveExpr, 
+			symbols.T_57, 
+			symbols.NT_AdditiveExpr,
+		}, 
+		RangeExprTo0R3, 
+	},
 	RelationalExpr0R0: {
 		symbols.NT_RelationalExpr, 0, 0, 
 		symbols.Symbols{  
-			symbols.NT_AdditiveExpr,
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExpr0R0, 
 	},
 	RelationalExpr0R1: {
 		symbols.NT_RelationalExpr, 0, 1, 
 		symbols.Symbols{  
-			symbols.NT_AdditiveExpr,
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExpr0R1, 
 	},
@@ -2923,8 +3839,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprGreaterThan, 0, 0, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_16, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_17, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprGreaterThan0R0, 
 	},
@@ -2932,8 +3848,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprGreaterThan, 0, 1, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_16, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_17, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprGreaterThan0R1, 
 	},
@@ -2941,8 +3857,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprGreaterThan, 0, 2, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_16, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_17, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprGreaterThan0R2, 
 	},
@@ -2950,8 +3866,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprGreaterThan, 0, 3, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_16, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_17, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprGreaterThan0R3, 
 	},
@@ -2959,8 +3875,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprGreaterThanOrEqual, 0, 0, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_17, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_18, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprGreaterThanOrEqual0R0, 
 	},
@@ -2968,8 +3884,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprGreaterThanOrEqual, 0, 1, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_17, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_18, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprGreaterThanOrEqual0R1, 
 	},
@@ -2977,8 +3893,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprGreaterThanOrEqual, 0, 2, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_17, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_18, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprGreaterThanOrEqual0R2, 
 	},
@@ -2986,8 +3902,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprGreaterThanOrEqual, 0, 3, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_17, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_18, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprGreaterThanOrEqual0R3, 
 	},
@@ -2995,8 +3911,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprLessThan, 0, 0, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_13, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_14, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprLessThan0R0, 
 	},
@@ -3004,8 +3920,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprLessThan, 0, 1, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_13, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_14, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprLessThan0R1, 
 	},
@@ -3013,8 +3929,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprLessThan, 0, 2, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_13, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_14, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprLessThan0R2, 
 	},
@@ -3022,8 +3938,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprLessThan, 0, 3, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_13, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_14, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprLessThan0R3, 
 	},
@@ -3031,8 +3947,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprLessThanOrEqual, 0, 0, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_14, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_15, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprLessThanOrEqual0R0, 
 	},
@@ -3040,8 +3956,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprLessThanOrEqual, 0, 1, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_14, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_15, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprLessThanOrEqual0R1, 
 	},
@@ -3049,8 +3965,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprLessThanOrEqual, 0, 2, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_14, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_15, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprLessThanOrEqual0R2, 
 	},
@@ -3058,8 +3974,8 @@ var slots = map[Label]*Slot{
 		symbols.NT_RelationalExprLessThanOrEqual, 0, 3, 
 		symbols.Symbols{  
 			symbols.NT_RelationalExpr, 
-			symbols.T_14, 
-			symbols.NT_AdditiveExpr,
+			symbols.T_15, 
+			symbols.NT_RangeExpr,
 		}, 
 		RelationalExprLessThanOrEqual0R3, 
 	},
@@ -3105,6 +4021,86 @@ var slots = map[Label]*Slot{
 		}, 
 		RelativeLocationPath2R1, 
 	},
+	RelativeLocationPath3R0: {
+		symbols.NT_RelativeLocationPath, 3, 0, 
+		symbols.Symbols{  
+			symbols.NT_RelativeLocationPathWithExprStep,
+		}, 
+		RelativeLocationPath3R0, 
+	},
+	RelativeLocationPath3R1: {
+		symbols.NT_RelativeLocationPath, 3, 1, 
+		symbols.Symbols{  
+			symbols.NT_RelativeLocationPathWithExprStep,
+		}, 
+		RelativeLocationPath3R1, 
+	},
+	RelativeLocationPathWithExprStep0R0: {
+		symbols.NT_RelativeLocationPathWithExprStep, 0, 0, 
+		symbols.Symbols{  
+			symbols.NT_RelativeLocationPath, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		RelativeLocationPathWithExprStep0R0, 
+	},
+	RelativeLocationPathWithExprStep0R1: {
+		symbols.NT_RelativeLocationPathWithExprStep, 0, 1, 
+		symbols.Symbols{  
+			symbols.NT_RelativeLocationPath, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		RelativeLocationPathWithExprStep0R1, 
+	},
+	RelativeLocationPathWithExprStep0R2: {
+		symbols.NT_RelativeLocationPathWithExprStep, 0, 2, 
+		symbols.Symbols{  
+			symbols.NT_RelativeLocationPath, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		RelativeLocationPathWithExprStep0R2, 
+	},
+	RelativeLocationPathWithExprStep0R3: {
+		symbols.NT_RelativeLocationPathWithExprStep, 0, 3, 
+		symbols.Symbols{  
+			symbols.NT_RelativeLocationPath, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		RelativeLocationPathWithExprStep0R3, 
+	},
+	RelativeLocationPathWithExprStep0R4: {
+		symbols.NT_RelativeLocationPathWithExprStep, 0, 4, 
+		symbols.Symbols{  
+			symbols.NT_RelativeLocationPath, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		RelativeLocationPathWithExprStep0R4, 
+	},
+	RelativeLocationPathWithExprStep0R5: {
+		symbols.NT_RelativeLocationPathWithExprStep, 0, 5, 
+		symbols.Symbols{  
+			symbols.NT_RelativeLocationPath, 
+			symbols.T_9, 
+			symbols.T_1, 
+			symbols.NT_Expr, 
+			symbols.T_2,
+		}, 
+		RelativeLocationPathWithExprStep0R5, 
+	},
 	RelativeLocationPathWithStep0R0: {
 		symbols.NT_RelativeLocationPathWithStep, 0, 0, 
 		symbols.Symbols{  
@@ -3144,241 +4140,395 @@ var slots = map[Label]*Slot{
 	ReservedNameConflictResolver0R0: {
 		symbols.NT_ReservedNameConflictResolver, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_21,
+			symbols.T_22,
 		}, 
 		ReservedNameConflictResolver0R0, 
 	},
 	ReservedNameConflictResolver0R1: {
 		symbols.NT_ReservedNameConflictResolver, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_21,
+			symbols.T_22,
 		}, 
 		ReservedNameConflictResolver0R1, 
 	},
 	ReservedNameConflictResolver1R0: {
 		symbols.NT_ReservedNameConflictResolver, 1, 0, 
 		symbols.Symbols{  
-			symbols.T_22,
+			symbols.T_23,
 		}, 
 		ReservedNameConflictResolver1R0, 
 	},
 	ReservedNameConflictResolver1R1: {
 		symbols.NT_ReservedNameConflictResolver, 1, 1, 
 		symbols.Symbols{  
-			symbols.T_22,
+			symbols.T_23,
 		}, 
 		ReservedNameConflictResolver1R1, 
 	},
 	ReservedNameConflictResolver2R0: {
 		symbols.NT_ReservedNameConflictResolver, 2, 0, 
 		symbols.Symbols{  
-			symbols.T_24,
+			symbols.T_25,
 		}, 
 		ReservedNameConflictResolver2R0, 
 	},
 	ReservedNameConflictResolver2R1: {
 		symbols.NT_ReservedNameConflictResolver, 2, 1, 
 		symbols.Symbols{  
-			symbols.T_24,
+			symbols.T_25,
 		}, 
 		ReservedNameConflictResolver2R1, 
 	},
 	ReservedNameConflictResolver3R0: {
 		symbols.NT_ReservedNameConflictResolver, 3, 0, 
 		symbols.Symbols{  
-			symbols.T_25,
+			symbols.T_26,
 		}, 
 		ReservedNameConflictResolver3R0, 
 	},
 	ReservedNameConflictResolver3R1: {
 		symbols.NT_ReservedNameConflictResolver, 3, 1, 
 		symbols.Symbols{  
-			symbols.T_25,
+			symbols.T_26,
 		}, 
 		ReservedNameConflictResolver3R1, 
 	},
 	ReservedNameConflictResolver4R0: {
 		symbols.NT_ReservedNameConflictResolver, 4, 0, 
 		symbols.Symbols{  
-			symbols.T_27,
+			symbols.T_28,
 		}, 
 		ReservedNameConflictResolver4R0, 
 	},
 	ReservedNameConflictResolver4R1: {
 		symbols.NT_ReservedNameConflictResolver, 4, 1, 
 		symbols.Symbols{  
-			symbols.T_27,
+			symbols.T_28,
 		}, 
 		ReservedNameConflictResolver4R1, 
 	},
 	ReservedNameConflictResolver5R0: {
 		symbols.NT_ReservedNameConflictResolver, 5, 0, 
 		symbols.Symbols{  
-			symbols.T_28,
+			symbols.T_29,
 		}, 
 		ReservedNameConflictResolver5R0, 
 	},
 	ReservedNameConflictResolver5R1: {
 		symbols.NT_ReservedNameConflictResolver, 5, 1, 
 		symbols.Symbols{  
-			symbols.T_28,
+			symbols.T_29,
 		}, 
 		ReservedNameConflictResolver5R1, 
 	},
 	ReservedNameConflictResolver6R0: {
 		symbols.NT_ReservedNameConflictResolver, 6, 0, 
 		symbols.Symbols{  
-			symbols.T_32,
+			symbols.T_35,
 		}, 
 		ReservedNameConflictResolver6R0, 
 	},
 	ReservedNameConflictResolver6R1: {
 		symbols.NT_ReservedNameConflictResolver, 6, 1, 
 		symbols.Symbols{  
-			symbols.T_32,
+			symbols.T_35,
 		}, 
 		ReservedNameConflictResolver6R1, 
 	},
 	ReservedNameConflictResolver7R0: {
 		symbols.NT_ReservedNameConflictResolver, 7, 0, 
 		symbols.Symbols{  
-			symbols.T_33,
+			symbols.T_36,
 		}, 
 		ReservedNameConflictResolver7R0, 
 	},
 	ReservedNameConflictResolver7R1: {
 		symbols.NT_ReservedNameConflictResolver, 7, 1, 
 		symbols.Symbols{  
-			symbols.T_33,
+			symbols.T_36,
 		}, 
 		ReservedNameConflictResolver7R1, 
 	},
 	ReservedNameConflictResolver8R0: {
 		symbols.NT_ReservedNameConflictResolver, 8, 0, 
 		symbols.Symbols{  
-			symbols.T_35,
+			symbols.T_42,
 		}, 
 		ReservedNameConflictResolver8R0, 
 	},
 	ReservedNameConflictResolver8R1: {
 		symbols.NT_ReservedNameConflictResolver, 8, 1, 
 		symbols.Symbols{  
-			symbols.T_35,
+			symbols.T_42,
 		}, 
 		ReservedNameConflictResolver8R1, 
 	},
 	ReservedNameConflictResolver9R0: {
 		symbols.NT_ReservedNameConflictResolver, 9, 0, 
 		symbols.Symbols{  
-			symbols.T_39,
+			symbols.T_46,
 		}, 
 		ReservedNameConflictResolver9R0, 
 	},
 	ReservedNameConflictResolver9R1: {
 		symbols.NT_ReservedNameConflictResolver, 9, 1, 
 		symbols.Symbols{  
-			symbols.T_39,
+			symbols.T_46,
 		}, 
 		ReservedNameConflictResolver9R1, 
 	},
 	ReservedNameConflictResolver10R0: {
 		symbols.NT_ReservedNameConflictResolver, 10, 0, 
 		symbols.Symbols{  
-			symbols.T_40,
+			symbols.T_47,
 		}, 
 		ReservedNameConflictResolver10R0, 
 	},
 	ReservedNameConflictResolver10R1: {
 		symbols.NT_ReservedNameConflictResolver, 10, 1, 
 		symbols.Symbols{  
-			symbols.T_40,
+			symbols.T_47,
 		}, 
 		ReservedNameConflictResolver10R1, 
 	},
 	ReservedNameConflictResolver11R0: {
 		symbols.NT_ReservedNameConflictResolver, 11, 0, 
 		symbols.Symbols{  
-			symbols.T_41,
+			symbols.T_48,
 		}, 
 		ReservedNameConflictResolver11R0, 
 	},
 	ReservedNameConflictResolver11R1: {
 		symbols.NT_ReservedNameConflictResolver, 11, 1, 
 		symbols.Symbols{  
-			symbols.T_41,
+			symbols.T_48,
 		}, 
 		ReservedNameConflictResolver11R1, 
 	},
 	ReservedNameConflictResolver12R0: {
 		symbols.NT_ReservedNameConflictResolver, 12, 0, 
 		symbols.Symbols{  
-			symbols.T_43,
+			symbols.T_52,
 		}, 
 		ReservedNameConflictResolver12R0, 
 	},
 	ReservedNameConflictResolver12R1: {
 		symbols.NT_ReservedNameConflictResolver, 12, 1, 
 		symbols.Symbols{  
-			symbols.T_43,
+			symbols.T_52,
 		}, 
 		ReservedNameConflictResolver12R1, 
 	},
 	ReservedNameConflictResolver13R0: {
 		symbols.NT_ReservedNameConflictResolver, 13, 0, 
 		symbols.Symbols{  
-			symbols.T_26,
+			symbols.T_27,
 		}, 
 		ReservedNameConflictResolver13R0, 
 	},
 	ReservedNameConflictResolver13R1: {
 		symbols.NT_ReservedNameConflictResolver, 13, 1, 
 		symbols.Symbols{  
-			symbols.T_26,
+			symbols.T_27,
 		}, 
 		ReservedNameConflictResolver13R1, 
 	},
 	ReservedNameConflictResolver14R0: {
 		symbols.NT_ReservedNameConflictResolver, 14, 0, 
 		symbols.Symbols{  
-			symbols.T_45,
+			symbols.T_55,
 		}, 
 		ReservedNameConflictResolver14R0, 
 	},
 	ReservedNameConflictResolver14R1: {
 		symbols.NT_ReservedNameConflictResolver, 14, 1, 
 		symbols.Symbols{  
-			symbols.T_45,
+			symbols.T_55,
 		}, 
 		ReservedNameConflictResolver14R1, 
 	},
 	ReservedNameConflictResolver15R0: {
 		symbols.NT_ReservedNameConflictResolver, 15, 0, 
 		symbols.Symbols{  
-			symbols.T_42,
+			symbols.T_49,
 		}, 
 		ReservedNameConflictResolver15R0, 
 	},
 	ReservedNameConflictResolver15R1: {
 		symbols.NT_ReservedNameConflictResolver, 15, 1, 
 		symbols.Symbols{  
-			symbols.T_42,
+			symbols.T_49,
 		}, 
 		ReservedNameConflictResolver15R1, 
 	},
 	ReservedNameConflictResolver16R0: {
 		symbols.NT_ReservedNameConflictResolver, 16, 0, 
 		symbols.Symbols{  
-			symbols.T_37,
+			symbols.T_44,
 		}, 
 		ReservedNameConflictResolver16R0, 
 	},
 	ReservedNameConflictResolver16R1: {
 		symbols.NT_ReservedNameConflictResolver, 16, 1, 
 		symbols.Symbols{  
-			symbols.T_37,
+			symbols.T_44,
 		}, 
 		ReservedNameConflictResolver16R1, 
 	},
+	ReservedNameConflictResolver17R0: {
+		symbols.NT_ReservedNameConflictResolver, 17, 0, 
+		symbols.Symbols{  
+			symbols.T_38,
+		}, 
+		ReservedNameConflictResolver17R0, 
+	},
+	ReservedNameConflictResolver17R1: {
+		symbols.NT_ReservedNameConflictResolver, 17, 1, 
+		symbols.Symbols{  
+			symbols.T_38,
+		}, 
+		ReservedNameConflictResolver17R1, 
+	},
+	ReservedNameConflictResolver18R0: {
+		symbols.NT_ReservedNameConflictResolver, 18, 0, 
+		symbols.Symbols{  
+			symbols.T_56,
+		}, 
+		ReservedNameConflictResolver18R0, 
+	},
+	ReservedNameConflictResolver18R1: {
+		symbols.NT_ReservedNameConflictResolver, 18, 1, 
+		symbols.Symbols{  
+			symbols.T_56,
+		}, 
+		ReservedNameConflictResolver18R1, 
+	},
+	ReservedNameConflictResolver19R0: {
+		symbols.NT_ReservedNameConflictResolver, 19, 0, 
+		symbols.Symbols{  
+			symbols.T_33,
+		}, 
+		ReservedNameConflictResolver19R0, 
+	},
+	ReservedNameConflictResolver19R1: {
+		symbols.NT_ReservedNameConflictResolver, 19, 1, 
+		symbols.Symbols{  
+			symbols.T_33,
+		}, 
+		ReservedNameConflictResolver19R1, 
+	},
+	ReservedNameConflictResolver20R0: {
+		symbols.NT_ReservedNameConflictResolver, 20, 0, 
+		symbols.Symbols{  
+			symbols.T_40,
+		}, 
+		ReservedNameConflictResolver20R0, 
+	},
+	ReservedNameConflictResolver20R1: {
+		symbols.NT_ReservedNameConflictResolver, 20, 1, 
+		symbols.Symbols{  
+			symbols.T_40,
+		}, 
+		ReservedNameConflictResolver20R1, 
+	},
+	ReservedNameConflictResolver21R0: {
+		symbols.NT_ReservedNameConflictResolver, 21, 0, 
+		symbols.Symbols{  
+			symbols.T_37,
+		}, 
+		ReservedNameConflictResolver21R0, 
+	},
+	ReservedNameConflictResolver21R1: {
+		symbols.NT_ReservedNameConflictResolver, 21, 1, 
+		symbols.Symbols{  
+			symbols.T_37,
+		}, 
+		ReservedNameConflictResolver21R1, 
+	},
+	ReservedNameConflictResolver22R0: {
+		symbols.NT_ReservedNameConflictResolver, 22, 0, 
+		symbols.Symbols{  
+			symbols.T_39,
+		}, 
+		ReservedNameConflictResolver22R0, 
+	},
+	ReservedNameConflictResolver22R1: {
+		symbols.NT_ReservedNameConflictResolver, 22, 1, 
+		symbols.Symbols{  
+			symbols.T_39,
+		}, 
+		ReservedNameConflictResolver22R1, 
+	},
+	ReservedNameConflictResolver23R0: {
+		symbols.NT_ReservedNameConflictResolver, 23, 0, 
+		symbols.Symbols{  
+			symbols.T_50,
+		}, 
+		ReservedNameConflictResolver23R0, 
+	},
+	ReservedNameConflictResolver23R1: {
+		symbols.NT_ReservedNameConflictResolver, 23, 1, 
+		symbols.Symbols{  
+			symbols.T_50,
+		}, 
+		ReservedNameConflictResolver23R1, 
+	},
+	ReservedNameConflictResolver24R0: {
+		symbols.NT_ReservedNameConflictResolver, 24, 0, 
+		symbols.Symbols{  
+			symbols.T_54,
+		}, 
+		ReservedNameConflictResolver24R0, 
+	},
+	ReservedNameConflictResolver24R1: {
+		symbols.NT_ReservedNameConflictResolver, 24, 1, 
+		symbols.Symbols{  
+			symbols.T_54,
+		}, 
+		ReservedNameConflictResolver24R1, 
+	},
+	ReservedNameConflictResolver25R0: {
+		symbols.NT_ReservedNameConflictResolver, 25, 0, 
+		symbols.Symbols{  
+			symbols.T_34,
+		}, 
+		ReservedNameConflictResolver25R0, 
+	},
+	ReservedNameConflictResolver25R1: {
+		symbols.NT_ReservedNameConflictResolver, 25, 1, 
+		symbols.Symbols{  
+			symbols.T_34,
+		}, 
+		ReservedNameConflictResolver25R1, 
+	},
+	ReservedNameConflictResolver26R0: {
+		symbols.NT_ReservedNameConflictResolver, 26, 0, 
+		symbols.Symbols{  
+			symbols.T_51,
+		}, 
+		ReservedNameConflictResolver26R0, 
+	},
+	ReservedNameConflictResolver26R1: {
+		symbols.NT_ReservedNameConflictResolver, 26, 1, 
+		symbols.Symbols{  
+			symbols.T_51,
+		}, 
+		ReservedNameConflictResolver26R1, 
+	},
+	ReservedNameConflictResolver27R0: {
+		symbols.NT_ReservedNameConflictResolver, 27, 0, 
+		symbols.Symbols{  
+			symbols.T_57,
+		}, 
+		ReservedNameConflictResolver27R0, 
+	},
+	ReservedNameConflictResolver27R1: {
+		symbols.NT_ReservedNameConflictResolver, 27, 1, 
+		symbols.Symbols{  
+			symbols.T_57,
+		}, 
+		ReservedNameConflictResolver27R1, 
+	},
 	Step0R0: {
 		symbols.NT_Step, 0, 0, 
 		symbols.Symbols{  
@@ -3647,7 +4797,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_UnionExprUnion, 0, 0, 
 		symbols.Symbols{  
 			symbols.NT_UnionExpr, 
-			symbols.T_47, 
+			symbols.T_59, 
 			symbols.NT_PathExpr,
 		}, 
 		UnionExprUnion0R0, 
@@ -3656,7 +4806,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_UnionExprUnion, 0, 1, 
 		symbols.Symbols{  
 			symbols.NT_UnionExpr, 
-			symbols.T_47, 
+			symbols.T_59, 
 			symbols.NT_PathExpr,
 		}, 
 		UnionExprUnion0R1, 
@@ -3665,7 +4815,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_UnionExprUnion, 0, 2, 
 		symbols.Symbols{  
 			symbols.NT_UnionExpr, 
-			symbols.T_47, 
+			symbols.T_59, 
 			symbols.NT_PathExpr,
 		}, 
 		UnionExprUnion0R2, 
@@ -3674,7 +4824,7 @@ var slots = map[Label]*Slot{
 		symbols.NT_UnionExprUnion, 0, 3, 
 		symbols.Symbols{  
 			symbols.NT_UnionExpr, 
-			symbols.T_47, 
+			symbols.T_59, 
 			symbols.NT_PathExpr,
 		}, 
 		UnionExprUnion0R3, 
@@ -3682,14 +4832,14 @@ var slots = map[Label]*Slot{
 	VariableReference0R0: {
 		symbols.NT_VariableReference, 0, 0, 
 		symbols.Symbols{  
-			symbols.T_46,
+			symbols.T_58,
 		}, 
 		VariableReference0R0, 
 	},
 	VariableReference0R1: {
 		symbols.NT_VariableReference, 0, 1, 
 		symbols.Symbols{  
-			symbols.T_46,
+			symbols.T_58,
 		}, 
 		VariableReference0R1, 
 	},
@@ -3793,6 +4943,24 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_EqualityExprNotEqual,0,1 }: EqualityExprNotEqual0R1,
 	Index{ symbols.NT_EqualityExprNotEqual,0,2 }: EqualityExprNotEqual0R2,
 	Index{ symbols.NT_EqualityExprNotEqual,0,3 }: EqualityExprNotEqual0R3,
+	Index{ symbols.NT_Expr,0,0 }: Expr0R0,
+	Index{ symbols.NT_Expr,0,1 }: Expr0R1,
+	Index{ symbols.NT_Expr,1,0 }: Expr1R0,
+	Index{ symbols.NT_Expr,1,1 }: Expr1R1,
+	Index{ symbols.NT_ExprComma,0,0 }: ExprComma0R0,
+	Index{ symbols.NT_ExprComma,0,1 }: ExprComma0R1,
+	Index{ symbols.NT_ExprComma,0,2 }: ExprComma0R2,
+	Index{ symbols.NT_ExprComma,0,3 }: ExprComma0R3,
+	Index{ symbols.NT_ExprSingle,0,0 }: ExprSingle0R0,
+	Index{ symbols.NT_ExprSingle,0,1 }: ExprSingle0R1,
+	Index{ symbols.NT_ExprSingle,1,0 }: ExprSingle1R0,
+	Index{ symbols.NT_ExprSingle,1,1 }: ExprSingle1R1,
+	Index{ symbols.NT_ExprSingle,2,0 }: ExprSingle2R0,
+	Index{ symbols.NT_ExprSingle,2,1 }: ExprSingle2R1,
+	Index{ symbols.NT_ExprSingle,3,0 }: ExprSingle3R0,
+	Index{ symbols.NT_ExprSingle,3,1 }: ExprSingle3R1,
+	Index{ symbols.NT_ExprSingle,4,0 }: ExprSingle4R0,
+	Index{ symbols.NT_ExprSingle,4,1 }: ExprSingle4R1,
 	Index{ symbols.NT_FilterExpr,0,0 }: FilterExpr0R0,
 	Index{ symbols.NT_FilterExpr,0,1 }: FilterExpr0R1,
 	Index{ symbols.NT_FilterExpr,1,0 }: FilterExpr1R0,
@@ -3800,6 +4968,13 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_FilterExprWithPredicate,0,0 }: FilterExprWithPredicate0R0,
 	Index{ symbols.NT_FilterExprWithPredicate,0,1 }: FilterExprWithPredicate0R1,
 	Index{ symbols.NT_FilterExprWithPredicate,0,2 }: FilterExprWithPredicate0R2,
+	Index{ symbols.NT_ForExpr,0,0 }: ForExpr0R0,
+	Index{ symbols.NT_ForExpr,0,1 }: ForExpr0R1,
+	Index{ symbols.NT_ForExpr,0,2 }: ForExpr0R2,
+	Index{ symbols.NT_ForExpr,0,3 }: ForExpr0R3,
+	Index{ symbols.NT_ForExpr,0,4 }: ForExpr0R4,
+	Index{ symbols.NT_ForExpr,0,5 }: ForExpr0R5,
+	Index{ symbols.NT_ForExpr,0,6 }: ForExpr0R6,
 	Index{ symbols.NT_FunctionCall,0,0 }: FunctionCall0R0,
 	Index{ symbols.NT_FunctionCall,0,1 }: FunctionCall0R1,
 	Index{ symbols.NT_FunctionCall,0,2 }: FunctionCall0R2,
@@ -3821,6 +4996,22 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_FunctionSignature,1,1 }: FunctionSignature1R1,
 	Index{ symbols.NT_FunctionSignatureNoArgs,0,0 }: FunctionSignatureNoArgs0R0,
 	Index{ symbols.NT_FunctionSignatureNoArgs,0,1 }: FunctionSignatureNoArgs0R1,
+	Index{ symbols.NT_IfExpr,0,0 }: IfExpr0R0,
+	Index{ symbols.NT_IfExpr,0,1 }: IfExpr0R1,
+	Index{ symbols.NT_IfExpr,0,2 }: IfExpr0R2,
+	Index{ symbols.NT_IfExpr,0,3 }: IfExpr0R3,
+	Index{ symbols.NT_IfExpr,0,4 }: IfExpr0R4,
+	Index{ symbols.NT_IfExpr,0,5 }: IfExpr0R5,
+	Index{ symbols.NT_IfExpr,0,6 }: IfExpr0R6,
+	Index{ symbols.NT_IfExpr,0,7 }: IfExpr0R7,
+	Index{ symbols.NT_IfExpr,0,8 }: IfExpr0R8,
+	Index{ symbols.NT_LetExpr,0,0 }: LetExpr0R0,
+	Index{ symbols.NT_LetExpr,0,1 }: LetExpr0R1,
+	Index{ symbols.NT_LetExpr,0,2 }: LetExpr0R2,
+	Index{ symbols.NT_LetExpr,0,3 }: LetExpr0R3,
+	Index{ symbols.NT_LetExpr,0,4 }: LetExpr0R4,
+	Index{ symbols.NT_LetExpr,0,5 }: LetExpr0R5,
+	Index{ symbols.NT_LetExpr,0,6 }: LetExpr0R6,
 	Index{ symbols.NT_Literal,0,0 }: Literal0R0,
 	Index{ symbols.NT_Literal,0,1 }: Literal0R1,
 	Index{ symbols.NT_Literal,1,0 }: Literal1R0,
@@ -3958,10 +5149,18 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_PathExpr,2,1 }: PathExpr2R1,
 	Index{ symbols.NT_PathExpr,3,0 }: PathExpr3R0,
 	Index{ symbols.NT_PathExpr,3,1 }: PathExpr3R1,
+	Index{ symbols.NT_PathExpr,4,0 }: PathExpr4R0,
+	Index{ symbols.NT_PathExpr,4,1 }: PathExpr4R1,
 	Index{ symbols.NT_PathExprFilterWithAbbreviatedPath,0,0 }: PathExprFilterWithAbbreviatedPath0R0,
 	Index{ symbols.NT_PathExprFilterWithAbbreviatedPath,0,1 }: PathExprFilterWithAbbreviatedPath0R1,
 	Index{ symbols.NT_PathExprFilterWithAbbreviatedPath,0,2 }: PathExprFilterWithAbbreviatedPath0R2,
 	Index{ symbols.NT_PathExprFilterWithAbbreviatedPath,0,3 }: PathExprFilterWithAbbreviatedPath0R3,
+	Index{ symbols.NT_PathExprFilterWithExprStep,0,0 }: PathExprFilterWithExprStep0R0,
+	Index{ symbols.NT_PathExprFilterWithExprStep,0,1 }: PathExprFilterWithExprStep0R1,
+	Index{ symbols.NT_PathExprFilterWithExprStep,0,2 }: PathExprFilterWithExprStep0R2,
+	Index{ symbols.NT_PathExprFilterWithExprStep,0,3 }: PathExprFilterWithExprStep0R3,
+	Index{ symbols.NT_PathExprFilterWithExprStep,0,4 }: PathExprFilterWithExprStep0R4,
+	Index{ symbols.NT_PathExprFilterWithExprStep,0,5 }: PathExprFilterWithExprStep0R5,
 	Index{ symbols.NT_PathExprFilterWithPath,0,0 }: PathExprFilterWithPath0R0,
 	Index{ symbols.NT_PathExprFilterWithPath,0,1 }: PathExprFilterWithPath0R1,
 	Index{ symbols.NT_PathExprFilterWithPath,0,2 }: PathExprFilterWithPath0R2,
@@ -3980,6 +5179,11 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_PrimaryExpr,3,1 }: PrimaryExpr3R1,
 	Index{ symbols.NT_PrimaryExpr,4,0 }: PrimaryExpr4R0,
 	Index{ symbols.NT_PrimaryExpr,4,1 }: PrimaryExpr4R1,
+	Index{ symbols.NT_PrimaryExpr,5,0 }: PrimaryExpr5R0,
+	Index{ symbols.NT_PrimaryExpr,5,1 }: PrimaryExpr5R1,
+	Index{ symbols.NT_PrimaryExprEmptySequence,0,0 }: PrimaryExprEmptySequence0R0,
+	Index{ symbols.NT_PrimaryExprEmptySequence,0,1 }: PrimaryExprEmptySequence0R1,
+	Index{ symbols.NT_PrimaryExprEmptySequence,0,2 }: PrimaryExprEmptySequence0R2,
 	Index{ symbols.NT_PrimaryExprParenthetic,0,0 }: PrimaryExprParenthetic0R0,
 	Index{ symbols.NT_PrimaryExprParenthetic,0,1 }: PrimaryExprParenthetic0R1,
 	Index{ symbols.NT_PrimaryExprParenthetic,0,2 }: PrimaryExprParenthetic0R2,
@@ -3994,6 +5198,32 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_QNameNamespaceWithLocal,0,1 }: QNameNamespaceWithLocal0R1,
 	Index{ symbols.NT_QNameNamespaceWithLocal,0,2 }: QNameNamespaceWithLocal0R2,
 	Index{ symbols.NT_QNameNamespaceWithLocal,0,3 }: QNameNamespaceWithLocal0R3,
+	Index{ symbols.NT_QuantifiedExpr,0,0 }: QuantifiedExpr0R0,
+	Index{ symbols.NT_QuantifiedExpr,0,1 }: QuantifiedExpr0R1,
+	Index{ symbols.NT_QuantifiedExpr,1,0 }: QuantifiedExpr1R0,
+	Index{ symbols.NT_QuantifiedExpr,1,1 }: QuantifiedExpr1R1,
+	Index{ symbols.NT_QuantifiedExprEvery,0,0 }: QuantifiedExprEvery0R0,
+	Index{ symbols.NT_QuantifiedExprEvery,0,1 }: QuantifiedExprEvery0R1,
+	Index{ symbols.NT_QuantifiedExprEvery,0,2 }: QuantifiedExprEvery0R2,
+	Index{ symbols.NT_QuantifiedExprEvery,0,3 }: QuantifiedExprEvery0R3,
+	Index{ symbols.NT_QuantifiedExprEvery,0,4 }: QuantifiedExprEvery0R4,
+	Index{ symbols.NT_QuantifiedExprEvery,0,5 }: QuantifiedExprEvery0R5,
+	Index{ symbols.NT_QuantifiedExprEvery,0,6 }: QuantifiedExprEvery0R6,
+	Index{ symbols.NT_QuantifiedExprSome,0,0 }: QuantifiedExprSome0R0,
+	Index{ symbols.NT_QuantifiedExprSome,0,1 }: QuantifiedExprSome0R1,
+	Index{ symbols.NT_QuantifiedExprSome,0,2 }: QuantifiedExprSome0R2,
+	Index{ symbols.NT_QuantifiedExprSome,0,3 }: QuantifiedExprSome0R3,
+	Index{ symbols.NT_QuantifiedExprSome,0,4 }: QuantifiedExprSome0R4,
+	Index{ symbols.NT_QuantifiedExprSome,0,5 }: QuantifiedExprSome0R5,
+	Index{ symbols.NT_QuantifiedExprSome,0,6 }: QuantifiedExprSome0R6,
+	Index{ symbols.NT_RangeExpr,0,0 }: RangeExpr0R0,
+	Index{ symbols.NT_RangeExpr,0,1 }: RangeExpr0R1,
+	Index{ symbols.NT_RangeExpr,1,0 }: RangeExpr1R0,
+	Index{ symbols.NT_RangeExpr,1,1 }: RangeExpr1R1,
+	Index{ symbols.NT_RangeExprTo,0,0 }: RangeExprTo0R0,
+	Index{ symbols.NT_RangeExprTo,0,1 }: RangeExprTo0R1,
+	Index{ symbols.NT_RangeExprTo,0,2 }: RangeExprTo0R2,
+	Index{ symbols.NT_RangeExprTo,0,3 }: RangeExprTo0R3,
 	Index{ symbols.NT_RelationalExpr,0,0 }: RelationalExpr0R0,
 	Index{ symbols.NT_RelationalExpr,0,1 }: RelationalExpr0R1,
 	Index{ symbols.NT_RelationalExpr,1,0 }: RelationalExpr1R0,
@@ -4026,6 +5256,14 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_RelativeLocationPath,1,1 }: RelativeLocationPath1R1,
 	Index{ symbols.NT_RelativeLocationPath,2,0 }: RelativeLocationPath2R0,
 	Index{ symbols.NT_RelativeLocationPath,2,1 }: RelativeLocationPath2R1,
+	Index{ symbols.NT_RelativeLocationPath,3,0 }: RelativeLocationPath3R0,
+	Index{ symbols.NT_RelativeLocationPath,3,1 }: RelativeLocationPath3R1,
+	Index{ symbols.NT_RelativeLocationPathWithExprStep,0,0 }: RelativeLocationPathWithExprStep0R0,
+	Index{ symbols.NT_RelativeLocationPathWithExprStep,0,1 }: RelativeLocationPathWithExprStep0R1,
+	Index{ symbols.NT_RelativeLocationPathWithExprStep,0,2 }: RelativeLocationPathWithExprStep0R2,
+	Index{ symbols.NT_RelativeLocationPathWithExprStep,0,3 }: RelativeLocationPathWithExprStep0R3,
+	Index{ symbols.NT_RelativeLocationPathWithExprStep,0,4 }: RelativeLocationPathWithExprStep0R4,
+	Index{ symbols.NT_RelativeLocationPathWithExprStep,0,5 }: RelativeLocationPathWithExprStep0R5,
 	Index{ symbols.NT_RelativeLocationPathWithStep,0,0 }: RelativeLocationPathWithStep0R0,
 	Index{ symbols.NT_RelativeLocationPathWithStep,0,1 }: RelativeLocationPathWithStep0R1,
 	Index{ symbols.NT_RelativeLocationPathWithStep,0,2 }: RelativeLocationPathWithStep0R2,
@@ -4064,6 +5302,28 @@ var slotIndex = map[Index]Label {
 	Index{ symbols.NT_ReservedNameConflictResolver,15,1 }: ReservedNameConflictResolver15R1,
 	Index{ symbols.NT_ReservedNameConflictResolver,16,0 }: ReservedNameConflictResolver16R0,
 	Index{ symbols.NT_ReservedNameConflictResolver,16,1 }: ReservedNameConflictResolver16R1,
+	Index{ symbols.NT_ReservedNameConflictResolver,17,0 }: ReservedNameConflictResolver17R0,
+	Index{ symbols.NT_ReservedNameConflictResolver,17,1 }: ReservedNameConflictResolver17R1,
+	Index{ symbols.NT_ReservedNameConflictResolver,18,0 }: ReservedNameConflictResolver18R0,
+	Index{ symbols.NT_ReservedNameConflictResolver,18,1 }: ReservedNameConflictResolver18R1,
+	Index{ symbols.NT_ReservedNameConflictResolver,19,0 }: ReservedNameConflictResolver19R0,
+	Index{ symbols.NT_ReservedNameConflictResolver,19,1 }: ReservedNameConflictResolver19R1,
+	Index{ symbols.NT_ReservedNameConflictResolver,20,0 }: ReservedNameConflictResolver20R0,
+	Index{ symbols.NT_ReservedNameConflictResolver,20,1 }: ReservedNameConflictResolver20R1,
+	Index{ symbols.NT_ReservedNameConflictResolver,21,0 }: ReservedNameConflictResolver21R0,
+	Index{ symbols.NT_ReservedNameConflictResolver,21,1 }: ReservedNameConflictResolver21R1,
+	Index{ symbols.NT_ReservedNameConflictResolver,22,0 }: ReservedNameConflictResolver22R0,
+	Index{ symbols.NT_ReservedNameConflictResolver,22,1 }: ReservedNameConflictResolver22R1,
+	Index{ symbols.NT_ReservedNameConflictResolver,23,0 }: ReservedNameConflictResolver23R0,
+	Index{ symbols.NT_ReservedNameConflictResolver,23,1 }: ReservedNameConflictResolver23R1,
+	Index{ symbols.NT_ReservedNameConflictResolver,24,0 }: ReservedNameConflictResolver24R0,
+	Index{ symbols.NT_ReservedNameConflictResolver,24,1 }: ReservedNameConflictResolver24R1,
+	Index{ symbols.NT_ReservedNameConflictResolver,25,0 }: ReservedNameConflictResolver25R0,
+	Index{ symbols.NT_ReservedNameConflictResolver,25,1 }: ReservedNameConflictResolver25R1,
+	Index{ symbols.NT_ReservedNameConflictResolver,26,0 }: ReservedNameConflictResolver26R0,
+	Index{ symbols.NT_ReservedNameConflictResolver,26,1 }: ReservedNameConflictResolver26R1,
+	Index{ symbols.NT_ReservedNameConflictResolver,27,0 }: ReservedNameConflictResolver27R0,
+	Index{ symbols.NT_ReservedNameConflictResolver,27,1 }: ReservedNameConflictResolver27R1,
 	Index{ symbols.NT_Step,0,0 }: Step0R0,
 	Index{ symbols.NT_Step,0,1 }: Step0R1,
 	Index{ symbols.NT_Step,1,0 }: Step1R0,
@@ -4109,6 +5369,15 @@ var slotIndex = map[Index]Label {
 }
 
 var alternates = map[symbols.NT][]Label{ 
+	symbols.NT_Expr:[]Label{ Expr0R0,Expr1R0 },
+	symbols.NT_ExprComma:[]Label{ ExprComma0R0 },
+	symbols.NT_ExprSingle:[]Label{ ExprSingle0R0,ExprSingle1R0,ExprSingle2R0,ExprSingle3R0,ExprSingle4R0 },
+	symbols.NT_IfExpr:[]Label{ IfExpr0R0 },
+	symbols.NT_LetExpr:[]Label{ LetExpr0R0 },
+	symbols.NT_ForExpr:[]Label{ ForExpr0R0 },
+	symbols.NT_QuantifiedExpr:[]Label{ QuantifiedExpr0R0,QuantifiedExpr1R0 },
+	symbols.NT_QuantifiedExprSome:[]Label{ QuantifiedExprSome0R0 },
+	symbols.NT_QuantifiedExprEvery:[]Label{ QuantifiedExprEvery0R0 },
 	symbols.NT_OrExpr:[]Label{ OrExpr0R0,OrExpr1R0 },
 	symbols.NT_OrExprOr:[]Label{ OrExprOr0R0 },
 	symbols.NT_AndExpr:[]Label{ AndExpr0R0,AndExpr1R0 },
@@ -4121,6 +5390,8 @@ var alternates = map[symbols.NT][]Label{
 	symbols.NT_RelationalExprGreaterThan:[]Label{ RelationalExprGreaterThan0R0 },
 	symbols.NT_RelationalExprLessThanOrEqual:[]Label{ RelationalExprLessThanOrEqual0R0 },
 	symbols.NT_RelationalExprGreaterThanOrEqual:[]Label{ RelationalExprGreaterThanOrEqual0R0 },
+	symbols.NT_RangeExpr:[]Label{ RangeExpr0R0,RangeExpr1R0 },
+	symbols.NT_RangeExprTo:[]Label{ RangeExprTo0R0 },
 	symbols.NT_AdditiveExpr:[]Label{ AdditiveExpr0R0,AdditiveExpr1R0,AdditiveExpr2R0 },
 	symbols.NT_AdditiveExprAdd:[]Label{ AdditiveExprAdd0R0 },
 	symbols.NT_AdditiveExprSubtract:[]Label{ AdditiveExprSubtract0R0 },
@@ -4132,15 +5403,17 @@ var alternates = map[symbols.NT][]Label{
 	symbols.NT_UnaryExprNegate:[]Label{ UnaryExprNegate0R0 },
 	symbols.NT_UnionExpr:[]Label{ UnionExpr0R0,UnionExpr1R0 },
 	symbols.NT_UnionExprUnion:[]Label{ UnionExprUnion0R0 },
-	symbols.NT_PathExpr:[]Label{ PathExpr0R0,PathExpr1R0,PathExpr2R0,PathExpr3R0 },
+	symbols.NT_PathExpr:[]Label{ PathExpr0R0,PathExpr1R0,PathExpr2R0,PathExpr3R0,PathExpr4R0 },
 	symbols.NT_PathExprFilterWithPath:[]Label{ PathExprFilterWithPath0R0 },
 	symbols.NT_PathExprFilterWithAbbreviatedPath:[]Label{ PathExprFilterWithAbbreviatedPath0R0 },
+	symbols.NT_PathExprFilterWithExprStep:[]Label{ PathExprFilterWithExprStep0R0 },
 	symbols.NT_LocationPath:[]Label{ LocationPath0R0,LocationPath1R0 },
 	symbols.NT_AbsoluteLocationPath:[]Label{ AbsoluteLocationPath0R0,AbsoluteLocationPath1R0,AbsoluteLocationPath2R0 },
 	symbols.NT_AbsoluteLocationPathOnly:[]Label{ AbsoluteLocationPathOnly0R0 },
 	symbols.NT_AbsoluteLocationPathWithRelative:[]Label{ AbsoluteLocationPathWithRelative0R0 },
-	symbols.NT_RelativeLocationPath:[]Label{ RelativeLocationPath0R0,RelativeLocationPath1R0,RelativeLocationPath2R0 },
+	symbols.NT_RelativeLocationPath:[]Label{ RelativeLocationPath0R0,RelativeLocationPath1R0,RelativeLocationPath2R0,RelativeLocationPath3R0 },
 	symbols.NT_RelativeLocationPathWithStep:[]Label{ RelativeLocationPathWithStep0R0 },
+	symbols.NT_RelativeLocationPathWithExprStep:[]Label{ RelativeLocationPathWithExprStep0R0 },
 	symbols.NT_AbbreviatedAbsoluteLocationPath:[]Label{ AbbreviatedAbsoluteLocationPath0R0 },
 	symbols.NT_AbbreviatedRelativeLocationPath:[]Label{ AbbreviatedRelativeLocationPath0R0 },
 	symbols.NT_Step:[]Label{ Step0R0,Step1R0,Step2R0,Step3R0,Step4R0,Step5R0 },
@@ -4171,13 +5444,14 @@ var alternates = map[symbols.NT][]Label{
 	symbols.NT_NameTestQNameNamespaceWithLocalReservedNameConflictBoth:[]Label{ NameTestQNameNamespaceWithLocalReservedNameConflictBoth0R0 },
 	symbols.NT_NameTestQNameLocalOnly:[]Label{ NameTestQNameLocalOnly0R0 },
 	symbols.NT_NameTestQNameLocalOnlyReservedNameConflict:[]Label{ NameTestQNameLocalOnlyReservedNameConflict0R0 },
-	symbols.NT_ReservedNameConflictResolver:[]Label{ ReservedNameConflictResolver0R0,ReservedNameConflictResolver1R0,ReservedNameConflictResolver2R0,ReservedNameConflictResolver3R0,ReservedNameConflictResolver4R0,ReservedNameConflictResolver5R0,ReservedNameConflictResolver6R0,ReservedNameConflictResolver7R0,ReservedNameConflictResolver8R0,ReservedNameConflictResolver9R0,ReservedNameConflictResolver10R0,ReservedNameConflictResolver11R0,ReservedNameConflictResolver12R0,ReservedNameConflictResolver13R0,ReservedNameConflictResolver14R0,ReservedNameConflictResolver15R0,ReservedNameConflictResolver16R0 },
+	symbols.NT_ReservedNameConflictResolver:[]Label{ ReservedNameConflictResolver0R0,ReservedNameConflictResolver1R0,ReservedNameConflictResolver2R0,ReservedNameConflictResolver3R0,ReservedNameConflictResolver4R0,ReservedNameConflictResolver5R0,ReservedNameConflictResolver6R0,ReservedNameConflictResolver7R0,ReservedNameConflictResolver8R0,ReservedNameConflictResolver9R0,ReservedNameConflictResolver10R0,ReservedNameConflictResolver11R0,ReservedNameConflictResolver12R0,ReservedNameConflictResolver13R0,ReservedNameConflictResolver14R0,ReservedNameConflictResolver15R0,ReservedNameConflictResolver16R0,ReservedNameConflictResolver17R0,ReservedNameConflictResolver18R0,ReservedNameConflictResolver19R0,ReservedNameConflictResolver20R0,ReservedNameConflictResolver21R0,ReservedNameConflictResolver22R0,ReservedNameConflictResolver23R0,ReservedNameConflictResolver24R0,ReservedNameConflictResolver25R0,ReservedNameConflictResolver26R0,ReservedNameConflictResolver27R0 },
 	symbols.NT_NodeType:[]Label{ NodeType0R0,NodeType1R0,NodeType2R0,NodeType3R0 },
 	symbols.NT_Literal:[]Label{ Literal0R0,Literal1R0 },
 	symbols.NT_FilterExpr:[]Label{ FilterExpr0R0,FilterExpr1R0 },
 	symbols.NT_FilterExprWithPredicate:[]Label{ FilterExprWithPredicate0R0 },
-	symbols.NT_PrimaryExpr:[]Label{ PrimaryExpr0R0,PrimaryExpr1R0,PrimaryExpr2R0,PrimaryExpr3R0,PrimaryExpr4R0 },
+	symbols.NT_PrimaryExpr:[]Label{ PrimaryExpr0R0,PrimaryExpr1R0,PrimaryExpr2R0,PrimaryExpr3R0,PrimaryExpr4R0,PrimaryExpr5R0 },
 	symbols.NT_PrimaryExprParenthetic:[]Label{ PrimaryExprParenthetic0R0 },
+	symbols.NT_PrimaryExprEmptySequence:[]Label{ PrimaryExprEmptySequence0R0 },
 	symbols.NT_Number:[]Label{ Number0R0,Number1R0,Number2R0 },
 	symbols.NT_VariableReference:[]Label{ VariableReference0R0 },
 	symbols.NT_FunctionCall:[]Label{ FunctionCall0R0 },
@@ -39,14 +39,20 @@ const(
 	NT_EqualityExpr 
 	NT_EqualityExprEqual 
 	NT_EqualityExprNotEqual 
+	NT_Expr 
+	NT_ExprComma 
+	NT_ExprSingle 
 	NT_FilterExpr 
 	NT_FilterExprWithPredicate 
+	NT_ForExpr 
 	NT_FunctionCall 
 	NT_FunctionCallArgumentList 
 	NT_FunctionCallArgumentListArgWithNext 
 	NT_FunctionCallArgumentListEndArg 
 	NT_FunctionSignature 
 	NT_FunctionSignatureNoArgs 
+	NT_IfExpr 
+	NT_LetExpr 
 	NT_Literal 
 	NT_LocationPath 
 	NT_MultiplicativeExpr 
@@ -74,19 +80,27 @@ const(
 	NT_OrExprOr 
 	NT_PathExpr 
 	NT_PathExprFilterWithAbbreviatedPath 
+	NT_PathExprFilterWithExprStep 
 	NT_PathExprFilterWithPath 
 	NT_Predicate 
 	NT_PrimaryExpr 
+	NT_PrimaryExprEmptySequence 
 	NT_PrimaryExprParenthetic 
 	NT_QName 
 	NT_QNameLocalOnly 
 	NT_QNameNamespaceWithLocal 
+	NT_QuantifiedExpr 
+	NT_QuantifiedExprEvery 
+	NT_QuantifiedExprSome 
+	NT_RangeExpr 
+	NT_RangeExprTo 
 	NT_RelationalExpr 
 	NT_RelationalExprGreaterThan 
 	NT_RelationalExprGreaterThanOrEqual 
 	NT_RelationalExprLessThan 
 	NT_RelationalExprLessThanOrEqual 
 	NT_RelativeLocationPath 
+	NT_RelativeLocationPathWithExprStep 
 	NT_RelativeLocationPathWithStep 
 	NT_ReservedNameConflictResolver 
 	NT_Step 
@@ -117,41 +131,53 @@ const(
 	T_10  // // 
 	T_11  // : 
 	T_12  // :: 
-	T_13  // < 
-	T_14  // <= 
-	T_15  // = 
-	T_16  // > 
-	T_17  // >= 
-	T_18  // @ 
-	T_19  // [ 
-	T_20  // ] 
-	T_21  // ancestor 
-	T_22  // ancestor-or-self 
-	T_23  // and 
-	T_24  // attribute 
-	T_25  // child 
-	T_26  // comment 
-	T_27  // descendant 
-	T_28  // descendant-or-self 
-	T_29  // digits 
-	T_30  // div 
-	T_31  // doublequote 
-	T_32  // following 
-	T_33  // following-sibling 
-	T_34  // mod 
-	T_35  // namespace 
-	T_36  // ncname 
-	T_37  // node 
-	T_38  // or 
-	T_39  // parent 
-	T_40  // preceding 
-	T_41  // preceding-sibling 
-	T_42  // processing-instruction 
-	T_43  // self 
-	T_44  // singlequote 
-	T_45  // text 
-	T_46  // variableReference 
-	T_47  // | 
+	T_13  // := 
+	T_14  // < 
+	T_15  // <= 
+	T_16  // = 
+	T_17  // > 
+	T_18  // >= 
+	T_19  // @ 
+	T_20  // [ 
+	T_21  // ] 
+	T_22  // ancestor 
+	T_23  // ancestor-or-self 
+	T_24  // and 
+	T_25  // attribute 
+	T_26  // child 
+	T_27  // comment 
+	T_28  // descendant 
+	T_29  // descendant-or-self 
+	T_30  // digits 
+	T_31  // div 
+	T_32  // doublequote 
+	T_33  // else 
+	T_34  // every 
+	T_35  // following 
+	T_36  // following-sibling 
+	T_37  // for 
+	T_38  // if 
+	T_39  // in 
+	T_40  // let 
+	T_41  // mod 
+	T_42  // namespace 
+	T_43  // ncname 
+	T_44  // node 
+	T_45  // or 
+	T_46  // parent 
+	T_47  // preceding 
+	T_48  // preceding-sibling 
+	T_49  // processing-instruction 
+	T_50  // return 
+	T_51  // satisfies 
+	T_52  // self 
+	T_53  // singlequote 
+	T_54  // some 
+	T_55  // text 
+	T_56  // then 
+	T_57  // to 
+	T_58  // variableReference 
+	T_59  // | 
 )
 
 type Symbols []Symbol
@@ -239,14 +265,20 @@ var ntToString = []string {
 	"EqualityExpr", /* NT_EqualityExpr */
 	"EqualityExprEqual", /* NT_EqualityExprEqual */
 	"EqualityExprNotEqual", /* NT_EqualityExprNotEqual */
+	"Expr", /* NT_Expr */
+	"ExprComma", /* NT_ExprComma */
+	"ExprSingle", /* NT_ExprSingle */
 	"FilterExpr", /* NT_FilterExpr */
 	"FilterExprWithPredicate", /* NT_FilterExprWithPredicate */
+	"ForExpr", /* NT_ForExpr */
 	"FunctionCall", /* NT_FunctionCall */
 	"FunctionCallArgumentList", /* NT_FunctionCallArgumentList */
 	"FunctionCallArgumentListArgWithNext", /* NT_FunctionCallArgumentListArgWithNext */
 	"FunctionCallArgumentListEndArg", /* NT_FunctionCallArgumentListEndArg */
 	"FunctionSignature", /* NT_FunctionSignature */
 	"FunctionSignatureNoArgs", /* NT_FunctionSignatureNoArgs */
+	"IfExpr", /* NT_IfExpr */
+	"LetExpr", /* NT_LetExpr */
 	"Literal", /* NT_Literal */
 	"LocationPath", /* NT_LocationPath */
 	"MultiplicativeExpr", /* NT_MultiplicativeExpr */
@@ -274,19 +306,27 @@ var ntToString = []string {
 	"OrExprOr", /* NT_OrExprOr */
 	"PathExpr", /* NT_PathExpr */
 	"PathExprFilterWithAbbreviatedPath", /* NT_PathExprFilterWithAbbreviatedPath */
+	"PathExprFilterWithExprStep", /* NT_PathExprFilterWithExprStep */
 	"PathExprFilterWithPath", /* NT_PathExprFilterWithPath */
 	"Predicate", /* NT_Predicate */
 	"PrimaryExpr", /* NT_PrimaryExpr */
+	"PrimaryExprEmptySequence", /* NT_PrimaryExprEmptySequence */
 	"PrimaryExprParenthetic", /* NT_PrimaryExprParenthetic */
 	"QName", /* NT_QName */
 	"QNameLocalOnly", /* NT_QNameLocalOnly */
 	"QNameNamespaceWithLocal", /* NT_QNameNamespaceWithLocal */
+	"QuantifiedExpr", /* NT_QuantifiedExpr */
+	"QuantifiedExprEvery", /* NT_QuantifiedExprEvery */
+	"QuantifiedExprSome", /* NT_QuantifiedExprSome */
+	"RangeExpr", /* NT_RangeExpr */
+	"RangeExprTo", /* NT_RangeExprTo */
 	"RelationalExpr", /* NT_RelationalExpr */
 	"RelationalExprGreaterThan", /* NT_RelationalExprGreaterThan */
 	"RelationalExprGreaterThanOrEqual", /* NT_RelationalExprGreaterThanOrEqual */
 	"RelationalExprLessThan", /* NT_RelationalExprLessThan */
 	"RelationalExprLessThanOrEqual", /* NT_RelationalExprLessThanOrEqual */
 	"RelativeLocationPath", /* NT_RelativeLocationPath */
+	"RelativeLocationPathWithExprStep", /* NT_RelativeLocationPathWithExprStep */
 	"RelativeLocationPathWithStep", /* NT_RelativeLocationPathWithStep */
 	"ReservedNameConflictResolver", /* NT_ReservedNameConflictResolver */
 	"Step", /* NT_Step */
@@ -315,41 +355,53 @@ var tToString = []string {
 	"//", /* T_10 */
 	":", /* T_11 */
 	"::", /* T_12 */
-	"<", /* T_13 */
-	"<=", /* T_14 */
-	"=", /* T_15 */
-	">", /* T_16 */
-	">=", /* T_17 */
-	"@", /* T_18 */
-	"[", /* T_19 */
-	"]", /* T_20 */
-	"ancestor", /* T_21 */
-	"ancestor-or-self", /* T_22 */
-	"and", /* T_23 */
-	"attribute", /* T_24 */
-	"child", /* T_25 */
-	"comment", /* T_26 */
-	"descendant", /* T_27 */
-	"descendant-or-self", /* T_28 */
-	"digits", /* T_29 */
-	"div", /* T_30 */
-	"doublequote", /* T_31 */
-	"following", /* T_32 */
-	"following-sibling", /* T_33 */
-	"mod", /* T_34 */
-	"namespace", /* T_35 */
-	"ncname", /* T_36 */
-	"node", /* T_37 */
-	"or", /* T_38 */
-	"parent", /* T_39 */
-	"preceding", /* T_40 */
-	"preceding-sibling", /* T_41 */
-	"processing-instruction", /* T_42 */
-	"self", /* T_43 */
-	"singlequote", /* T_44 */
-	"text", /* T_45 */
-	"variableReference", /* T_46 */
-	"|", /* T_47 */ 
+	":=", /* T_13 */
+	"<", /* T_14 */
+	"<=", /* T_15 */
+	"=", /* T_16 */
+	">", /* T_17 */
+	">=", /* T_18 */
+	"@", /* T_19 */
+	"[", /* T_20 */
+	"]", /* T_21 */
+	"ancestor", /* T_22 */
+	"ancestor-or-self", /* T_23 */
+	"and", /* T_24 */
+	"attribute", /* T_25 */
+	"child", /* T_26 */
+	"comment", /* T_27 */
+	"descendant", /* T_28 */
+	"descendant-or-self", /* T_29 */
+	"digits", /* T_30 */
+	"div", /* T_31 */
+	"doublequote", /* T_32 */
+	"else", /* T_33 */
+	"every", /* T_34 */
+	"following", /* T_35 */
+	"following-sibling", /* T_36 */
+	"for", /* T_37 */
+	"if", /* T_38 */
+	"in", /* T_39 */
+	"let", /* T_40 */
+	"mod", /* T_41 */
+	"namespace", /* T_42 */
+	"ncname", /* T_43 */
+	"node", /* T_44 */
+	"or", /* T_45 */
+	"parent", /* T_46 */
+	"preceding", /* T_47 */
+	"preceding-sibling", /* T_48 */
+	"processing-instruction", /* T_49 */
+	"return", /* T_50 */
+	"satisfies", /* T_51 */
+	"self", /* T_52 */
+	"singlequote", /* T_53 */
+	"some", /* T_54 */
+	"text", /* T_55 */
+	"then", /* T_56 */
+	"to", /* T_57 */
+	"variableReference", /* T_58 */
+	"|", /* T_59 */ 
 }
 
 var stringNT = map[string]NT{ 
@@ -373,14 +425,20 @@ var stringNT = map[string]NT{
 	"EqualityExpr":NT_EqualityExpr,
 	"EqualityExprEqual":NT_EqualityExprEqual,
 	"EqualityExprNotEqual":NT_EqualityExprNotEqual,
+	"Expr":NT_Expr,
+	"ExprComma":NT_ExprComma,
+	"ExprSingle":NT_ExprSingle,
 	"FilterExpr":NT_FilterExpr,
 	"FilterExprWithPredicate":NT_FilterExprWithPredicate,
+	"ForExpr":NT_ForExpr,
 	"FunctionCall":NT_FunctionCall,
 	"FunctionCallArgumentList":NT_FunctionCallArgumentList,
 	"FunctionCallArgumentListArgWithNext":NT_FunctionCallArgumentListArgWithNext,
 	"FunctionCallArgumentListEndArg":NT_FunctionCallArgumentListEndArg,
 	"FunctionSignature":NT_FunctionSignature,
 	"FunctionSignatureNoArgs":NT_FunctionSignatureNoArgs,
+	"IfExpr":NT_IfExpr,
+	"LetExpr":NT_LetExpr,
 	"Literal":NT_Literal,
 	"LocationPath":NT_LocationPath,
 	"MultiplicativeExpr":NT_MultiplicativeExpr,
@@ -408,19 +466,27 @@ var stringNT = map[string]NT{
 	"OrExprOr":NT_OrExprOr,
 	"PathExpr":NT_PathExpr,
 	"PathExprFilterWithAbbreviatedPath":NT_PathExprFilterWithAbbreviatedPath,
+	"PathExprFilterWithExprStep":NT_PathExprFilterWithExprStep,
 	"PathExprFilterWithPath":NT_PathExprFilterWithPath,
 	"Predicate":NT_Predicate,
 	"PrimaryExpr":NT_PrimaryExpr,
+	"PrimaryExprEmptySequence":NT_PrimaryExprEmptySequence,
 	"PrimaryExprParenthetic":NT_PrimaryExprParenthetic,
 	"QName":NT_QName,
 	"QNameLocalOnly":NT_QNameLocalOnly,
 	"QNameNamespaceWithLocal":NT_QNameNamespaceWithLocal,
+	"QuantifiedExpr":NT_QuantifiedExpr,
+	"QuantifiedExprEvery":NT_QuantifiedExprEvery,
+	"QuantifiedExprSome":NT_QuantifiedExprSome,
+	"RangeExpr":NT_RangeExpr,
+	"RangeExprTo":NT_RangeExprTo,
 	"RelationalExpr":NT_RelationalExpr,
 	"RelationalExprGreaterThan":NT_RelationalExprGreaterThan,
 	"RelationalExprGreaterThanOrEqual":NT_RelationalExprGreaterThanOrEqual,
 	"RelationalExprLessThan":NT_RelationalExprLessThan,
 	"RelationalExprLessThanOrEqual":NT_RelationalExprLessThanOrEqual,
 	"RelativeLocationPath":NT_RelativeLocationPath,
+	"RelativeLocationPathWithExprStep":NT_RelativeLocationPathWithExprStep,
 	"RelativeLocationPathWithStep":NT_RelativeLocationPathWithStep,
 	"ReservedNameConflictResolver":NT_ReservedNameConflictResolver,
 	"Step":NT_Step,
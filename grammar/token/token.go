@@ -148,41 +148,53 @@ const(
     T_10  // // 
     T_11  // : 
     T_12  // :: 
-    T_13  // < 
-    T_14  // <= 
-    T_15  // = 
-    T_16  // > 
-    T_17  // >= 
-    T_18  // @ 
-    T_19  // [ 
-    T_20  // ] 
-    T_21  // ancestor 
-    T_22  // ancestor-or-self 
-    T_23  // and 
-    T_24  // attribute 
-    T_25  // child 
-    T_26  // comment 
-    T_27  // descendant 
-    T_28  // descendant-or-self 
-    T_29  // digits 
-    T_30  // div 
-    T_31  // doublequote 
-    T_32  // following 
-    T_33  // following-sibling 
-    T_34  // mod 
-    T_35  // namespace 
-    T_36  // ncname 
-    T_37  // node 
-    T_38  // or 
-    T_39  // parent 
-    T_40  // preceding 
-    T_41  // preceding-sibling 
-    T_42  // processing-instruction 
-    T_43  // self 
-    T_44  // singlequote 
-    T_45  // text 
-    T_46  // variableReference 
-    T_47  // | 
+    T_13  // := 
+    T_14  // < 
+    T_15  // <= 
+    T_16  // = 
+    T_17  // > 
+    T_18  // >= 
+    T_19  // @ 
+    T_20  // [ 
+    T_21  // ] 
+    T_22  // ancestor 
+    T_23  // ancestor-or-self 
+    T_24  // and 
+    T_25  // attribute 
+    T_26  // child 
+    T_27  // comment 
+    T_28  // descendant 
+    T_29  // descendant-or-self 
+    T_30  // digits 
+    T_31  // div 
+    T_32  // doublequote 
+    T_33  // else 
+    T_34  // every 
+    T_35  // following 
+    T_36  // following-sibling 
+    T_37  // for 
+    T_38  // if 
+    T_39  // in 
+    T_40  // let 
+    T_41  // mod 
+    T_42  // namespace 
+    T_43  // ncname 
+    T_44  // node 
+    T_45  // or 
+    T_46  // parent 
+    T_47  // preceding 
+    T_48  // preceding-sibling 
+    T_49  // processing-instruction 
+    T_50  // return 
+    T_51  // satisfies 
+    T_52  // self 
+    T_53  // singlequote 
+    T_54  // some 
+    T_55  // text 
+    T_56  // then 
+    T_57  // to 
+    T_58  // variableReference 
+    T_59  // | 
 )
 
 var TypeToString = []string{ 
@@ -236,6 +248,18 @@ var TypeToString = []string{
     "T_45",
     "T_46",
     "T_47",
+    "T_48",
+    "T_49",
+    "T_50",
+    "T_51",
+    "T_52",
+    "T_53",
+    "T_54",
+    "T_55",
+    "T_56",
+    "T_57",
+    "T_58",
+    "T_59",
 }
 
 var StringToType = map[string] Type { 
@@ -289,6 +313,18 @@ var StringToType = map[string] Type {
     "T_45" : T_45, 
     "T_46" : T_46, 
     "T_47" : T_47, 
+    "T_48" : T_48, 
+    "T_49" : T_49, 
+    "T_50" : T_50, 
+    "T_51" : T_51, 
+    "T_52" : T_52, 
+    "T_53" : T_53, 
+    "T_54" : T_54, 
+    "T_55" : T_55, 
+    "T_56" : T_56, 
+    "T_57" : T_57, 
+    "T_58" : T_58, 
+    "T_59" : T_59, 
 }
 
 var TypeToID = []string { 
@@ -307,6 +343,7 @@ var TypeToID = []string {
     "//", 
     ":", 
     "::", 
+    ":=", 
     "<", 
     "<=", 
     "=", 
@@ -326,8 +363,14 @@ var TypeToID = []string {
     "digits", 
     "div", 
     "doublequote", 
+    "else", 
+    "every", 
     "following", 
     "following-sibling", 
+    "for", 
+    "if", 
+    "in", 
+    "let", 
     "mod", 
     "namespace", 
     "ncname", 
@@ -337,9 +380,14 @@ var TypeToID = []string {
     "preceding", 
     "preceding-sibling", 
     "processing-instruction", 
+    "return", 
+    "satisfies", 
     "self", 
     "singlequote", 
+    "some", 
     "text", 
+    "then", 
+    "to", 
     "variableReference", 
     "|", 
 }
@@ -360,41 +408,53 @@ var IDToType = map[string]Type {
     "//": 12, 
     ":": 13, 
     "::": 14, 
-    "<": 15, 
-    "<=": 16, 
-    "=": 17, 
-    ">": 18, 
-    ">=": 19, 
-    "@": 20, 
-    "[": 21, 
-    "]": 22, 
-    "ancestor": 23, 
-    "ancestor-or-self": 24, 
-    "and": 25, 
-    "attribute": 26, 
-    "child": 27, 
-    "comment": 28, 
-    "descendant": 29, 
-    "descendant-or-self": 30, 
-    "digits": 31, 
-    "div": 32, 
-    "doublequote": 33, 
-    "following": 34, 
-    "following-sibling": 35, 
-    "mod": 36, 
-    "namespace": 37, 
-    "ncname": 38, 
-    "node": 39, 
-    "or": 40, 
-    "parent": 41, 
-    "preceding": 42, 
-    "preceding-sibling": 43, 
-    "processing-instruction": 44, 
-    "self": 45, 
-    "singlequote": 46, 
-    "text": 47, 
-    "variableReference": 48, 
-    "|": 49, 
+    ":=": 15, 
+    "<": 16, 
+    "<=": 17, 
+    "=": 18, 
+    ">": 19, 
+    ">=": 20, 
+    "@": 21, 
+    "[": 22, 
+    "]": 23, 
+    "ancestor": 24, 
+    "ancestor-or-self": 25, 
+    "and": 26, 
+    "attribute": 27, 
+    "child": 28, 
+    "comment": 29, 
+    "descendant": 30, 
+    "descendant-or-self": 31, 
+    "digits": 32, 
+    "div": 33, 
+    "doublequote": 34, 
+    "else": 35, 
+    "every": 36, 
+    "following": 37, 
+    "following-sibling": 38, 
+    "for": 39, 
+    "if": 40, 
+    "in": 41, 
+    "let": 42, 
+    "mod": 43, 
+    "namespace": 44, 
+    "ncname": 45, 
+    "node": 46, 
+    "or": 47, 
+    "parent": 48, 
+    "preceding": 49, 
+    "preceding-sibling": 50, 
+    "processing-instruction": 51, 
+    "return": 52, 
+    "satisfies": 53, 
+    "self": 54, 
+    "singlequote": 55, 
+    "some": 56, 
+    "text": 57, 
+    "then": 58, 
+    "to": 59, 
+    "variableReference": 60, 
+    "|": 61, 
 }
 
 var Suppress = []bool { 
@@ -448,5 +508,17 @@ var Suppress = []bool {
     false, 
     false, 
     false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
+    false, 
 }
 
@@ -31,6 +31,12 @@ type Attribute interface {
 	AttributeValue() string
 }
 
+// Implemented by Attribute's that know whether they were declared with the ID type, such as in a DTD.
+type IDAttribute interface {
+	Attribute
+	IsID() bool
+}
+
 type CharData interface {
 	Node
 	CharDataValue() string
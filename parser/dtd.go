@@ -0,0 +1,120 @@
+package parser
+
+import (
+	"strings"
+	"unicode"
+)
+
+// dtdIDAttributes finds the attributes declared with the ID type in the
+// internal subset of a DOCTYPE declaration.  The result maps element names to
+// the names of their ID attributes.  Namespace prefixes are stripped from the
+// names, because the DTD has no knowledge of namespaces.
+func dtdIDAttributes(directive string) map[string]map[string]bool {
+	ret := make(map[string]map[string]bool)
+
+	if !strings.HasPrefix(directive, "DOCTYPE") {
+		return ret
+	}
+
+	for {
+		start := strings.Index(directive, "<!ATTLIST")
+
+		if start < 0 {
+			return ret
+		}
+
+		directive = directive[start+len("<!ATTLIST"):]
+		tokens, rest := dtdTokens(directive)
+		directive = rest
+
+		if len(tokens) == 0 {
+			continue
+		}
+
+		element := dtdLocalName(tokens[0])
+
+		for i := 1; i+1 < len(tokens); {
+			attr := tokens[i]
+			attrType := tokens[i+1]
+			i += 2
+
+			if attrType == "NOTATION" {
+				i++
+			}
+
+			if i < len(tokens) && tokens[i] == "#FIXED" {
+				i++
+			}
+
+			i++
+
+			if attrType == "ID" {
+				if ret[element] == nil {
+					ret[element] = make(map[string]bool)
+				}
+
+				ret[element][dtdLocalName(attr)] = true
+			}
+		}
+	}
+}
+
+// dtdTokens splits the body of an ATTLIST declaration into names, quoted
+// values and parenthesized groups, up to the closing '>'.  It returns the
+// tokens and the text after the declaration.
+func dtdTokens(decl string) ([]string, string) {
+	tokens := make([]string, 0)
+	runes := []rune(decl)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case r == '>':
+			return tokens, string(runes[i+1:])
+		case unicode.IsSpace(r):
+			i++
+		case r == '"' || r == '\'':
+			end := dtdScanPast(runes, i+1, r)
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+		case r == '(':
+			end := dtdScanPast(runes, i+1, ')')
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+		default:
+			end := i
+
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && runes[end] != '>' && runes[end] != '(' {
+				end++
+			}
+
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+		}
+	}
+
+	return tokens, ""
+}
+
+// dtdScanPast returns the index after the next occurrence of close, or the end
+// of runes if there isn't one.
+func dtdScanPast(runes []rune, i int, close rune) int {
+	for i < len(runes) {
+		i++
+
+		if runes[i-1] == close {
+			break
+		}
+	}
+
+	return i
+}
+
+func dtdLocalName(name string) string {
+	if colon := strings.LastIndex(name, ":"); colon >= 0 {
+		return name[colon+1:]
+	}
+
+	return name
+}
@@ -36,6 +36,7 @@ func (x XmlNamespace) NamespaceValue() string {
 
 type XmlAttribute struct {
 	space, local, value string
+	id                  bool
 }
 
 func (x XmlAttribute) Space() string {
@@ -50,6 +51,12 @@ func (x XmlAttribute) AttributeValue() string {
 	return x.value
 }
 
+// IsID returns true if the attribute was declared with the ID type in the
+// document's DTD.
+func (x XmlAttribute) IsID() bool {
+	return x.id
+}
+
 type XmlCharData struct {
 	value string
 }
@@ -87,6 +94,9 @@ type xmlParser struct {
 	nsPos      int
 	attrs      []XmlAttribute
 	attrPos    int
+	// idAttrs holds the ID attributes declared in the DTD, keyed by element
+	// name.
+	idAttrs map[string]map[string]bool
 }
 
 func (x *xmlParser) Pull() (node.Node, bool, error) {
@@ -117,7 +127,7 @@ func (x *xmlParser) Pull() (node.Node, bool, error) {
 	switch n := tok.(type) {
 	case xml.StartElement:
 		x.namespaces = createXmlNamespaces(n.Attr)
-		x.attrs = createXmlAttrs(n.Attr)
+		x.attrs = createXmlAttrs(n.Attr, x.idAttrs[n.Name.Local])
 		return XmlElement{
 			space: n.Name.Space,
 			local: n.Name.Local,
@@ -135,6 +145,9 @@ func (x *xmlParser) Pull() (node.Node, bool, error) {
 			target: n.Target,
 			value:  string(n.Inst),
 		}, false, nil
+	case xml.Directive:
+		x.idAttrs = dtdIDAttributes(string(n))
+		return x.Pull()
 	}
 
 	//case xml.EndElement:
@@ -173,7 +186,7 @@ func createXmlNamespaces(attrs []xml.Attr) []XmlNamespace {
 	return ret
 }
 
-func createXmlAttrs(attrs []xml.Attr) []XmlAttribute {
+func createXmlAttrs(attrs []xml.Attr, idAttrs map[string]bool) []XmlAttribute {
 	ret := make([]XmlAttribute, 0, len(attrs))
 
 	for _, i := range attrs {
@@ -185,6 +198,7 @@ func createXmlAttrs(attrs []xml.Attr) []XmlAttribute {
 			space: i.Name.Space,
 			local: i.Name.Local,
 			value: i.Value,
+			id:    idAttrs[i.Name.Local],
 		}
 
 		ret = append(ret, next)
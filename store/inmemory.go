@@ -3,6 +3,8 @@ package store
 import (
 	"errors"
 	"io"
+	"sort"
+	"sync"
 
 	"github.com/ChrisTrenkamp/xsel/node"
 	"github.com/ChrisTrenkamp/xsel/parser"
@@ -17,6 +19,23 @@ type InMemory struct {
 	namespaces []Cursor
 	attributes []Cursor
 	nodes      []Cursor
+	// memo is only set on the root.
+	memo *memo
+}
+
+type expandedName struct {
+	space, local string
+}
+
+// nameIndex holds every element in a document, grouped by name, in document
+// order.
+type nameIndex map[expandedName][]Cursor
+
+type nameIndexKey struct{}
+
+type memo struct {
+	lock   sync.Mutex
+	values map[any]any
 }
 
 func initElement() InMemory {
@@ -43,6 +62,7 @@ func CreateInMemory(parse parser.Parser) (*InMemory, error) {
 	root.node = rootInMemoryNode{}
 	root.pos = 0
 	root.parent = &root
+	root.memo = &memo{values: make(map[any]any)}
 	err := createInMemory(&root, parse, 0)
 	return &root, err
 }
@@ -154,3 +174,84 @@ func (c *InMemory) Children() []Cursor {
 func (c *InMemory) Parent() Cursor {
 	return c.parent
 }
+
+func (c *InMemory) root() *InMemory {
+	root := c
+
+	for root.parent != root {
+		root = root.parent
+	}
+
+	return root
+}
+
+func (c *InMemory) Memo(key any, build func() any) any {
+	m := c.root().memo
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if value, ok := m.values[key]; ok {
+		return value
+	}
+
+	value := build()
+	m.values[key] = value
+	return value
+}
+
+func (c *InMemory) DescendantsNamed(space, local string) []Cursor {
+	return c.elementsNamed(space, local, c.pos, c.lastDescendantPos())
+}
+
+func (c *InMemory) FollowingNamed(space, local string) []Cursor {
+	return c.elementsNamed(space, local, c.lastDescendantPos(), -1)
+}
+
+// elementsNamed returns the elements with the given name whose positions are
+// greater than after, and no greater than until.  An until of -1 means there
+// is no upper bound.
+func (c *InMemory) elementsNamed(space, local string, after, until int) []Cursor {
+	elements := c.nameIndex()[expandedName{space, local}]
+	start := sort.Search(len(elements), func(i int) bool {
+		return elements[i].Pos() > after
+	})
+	end := len(elements)
+
+	if until >= 0 {
+		end = sort.Search(len(elements), func(i int) bool {
+			return elements[i].Pos() > until
+		})
+	}
+
+	// Limit the capacity so appending to the result never writes into the index.
+	return elements[start:end:end]
+}
+
+// lastDescendantPos returns the position of the last element or child node
+// underneath this Cursor, or its own position if it has no children.
+func (c *InMemory) lastDescendantPos() int {
+	if len(c.nodes) == 0 {
+		return c.pos
+	}
+
+	return c.nodes[len(c.nodes)-1].(*InMemory).lastDescendantPos()
+}
+
+func (c *InMemory) nameIndex() nameIndex {
+	return c.Memo(nameIndexKey{}, func() any {
+		index := make(nameIndex)
+		indexNames(index, c.root())
+		return index
+	}).(nameIndex)
+}
+
+func indexNames(index nameIndex, c *InMemory) {
+	if element, ok := c.node.(node.Element); ok {
+		name := expandedName{element.Space(), element.Local()}
+		index[name] = append(index[name], c)
+	}
+
+	for _, i := range c.nodes {
+		indexNames(index, i.(*InMemory))
+	}
+}
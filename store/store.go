@@ -20,6 +20,27 @@ type Cursor interface {
 	Parent() Cursor
 }
 
+// Memoizer is an optional interface for Cursor's.  Memo returns the value stored
+// under key for the Cursor's document, calling build to create it the first
+// time it is requested.  It allows indexes that are expensive to build to be
+// built once per document, rather than once per query.  Memo must be safe to
+// call concurrently, and build must not call Memo.
+type Memoizer interface {
+	Memo(key any, build func() any) any
+}
+
+// NameIndexer is an optional interface for Cursor's that can look up elements
+// by their expanded name without walking the document.  It is only used on
+// Cursor's that hold an element or the root.
+type NameIndexer interface {
+	// DescendantsNamed returns the descendant elements of the Cursor with
+	// the given expanded name, in document order.
+	DescendantsNamed(space, local string) []Cursor
+	// FollowingNamed returns the elements with the given expanded name that
+	// come after the Cursor and its descendants, in document order.
+	FollowingNamed(space, local string) []Cursor
+}
+
 // A convenience method for retrieving a node.Attribute.
 func GetAttribute(c Cursor, space, local string) (node.Attribute, bool) {
 	for _, a := range c.Attributes() {
@@ -1,8 +1,12 @@
 package xsel
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/ChrisTrenkamp/xsel/exec"
 	"github.com/ChrisTrenkamp/xsel/grammar"
@@ -16,14 +20,64 @@ type Context = exec.Context
 type ContextApply = exec.ContextApply
 
 type Grammar = grammar.Grammar
+type SyntaxError = grammar.SyntaxError
+type Expr = exec.Expr
+type Query = exec.Query
+type Span = exec.Span
+
+type Tracer = exec.Tracer
+type TraceEvent = exec.TraceEvent
+type Profiler = exec.Profiler
+type ProfileStep = exec.ProfileStep
 
 type Bool = exec.Bool
 type Number = exec.Number
 type String = exec.String
 type NodeSet = exec.NodeSet
+type Sequence = exec.Sequence
 type Result = exec.Result
 type XmlName = exec.XmlName
 type Function = exec.Function
+type LazyFunction = exec.LazyFunction
+type Thunk = exec.Thunk
+type VariableResolver = exec.VariableResolver
+type FunctionResolver = exec.FunctionResolver
+type XPathFunction = exec.XPathFunction
+type FunctionLibrary = exec.FunctionLibrary
+type LibraryFunction = exec.LibraryFunction
+type FunctionInfo = exec.FunctionInfo
+type Signature = exec.Signature
+type Param = exec.Param
+type Type = exec.Type
+type Limit = exec.Limit
+type LimitError = exec.LimitError
+type CheckError = exec.CheckError
+type ExprError = exec.ExprError
+
+var (
+	ErrUnknownFunction = exec.ErrUnknownFunction
+	ErrUnboundVariable = exec.ErrUnboundVariable
+	ErrNotNodeSet      = exec.ErrNotNodeSet
+	ErrArity           = exec.ErrArity
+	ErrUnknownPrefix   = exec.ErrUnknownPrefix
+	ErrPanic           = exec.ErrPanic
+)
+
+const (
+	TypeAny      = exec.TypeAny
+	TypeString   = exec.TypeString
+	TypeNumber   = exec.TypeNumber
+	TypeBool     = exec.TypeBool
+	TypeNodeSet  = exec.TypeNodeSet
+	TypeSequence = exec.TypeSequence
+)
+
+const (
+	LimitNodesVisited = exec.LimitNodesVisited
+	LimitDepth        = exec.LimitDepth
+	LimitNodeSetSize  = exec.LimitNodeSetSize
+	LimitRecursion    = exec.LimitRecursion
+)
 
 type Node = node.Node
 type Root = node.Root
@@ -81,6 +135,214 @@ func WithFunctionName(name XmlName, fn Function) func(c *ContextSettings) {
 	}
 }
 
+// WithFunctionSignature binds a custom function with a declared Signature to
+// a XPath query.  Its arguments are checked and coerced to the parameter types
+// before it is called, and Check verifies the number of arguments it is
+// called with.
+func WithFunctionSignature(name XmlName, sig Signature, fn Function) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.FunctionLibrary[name] = sig.Bind(fn)
+		c.FunctionSignatures[name] = sig
+	}
+}
+
+// WithLazyFunction binds a custom function whose arguments are only evaluated
+// when it calls their Thunks, such as a function that short-circuits.
+func WithLazyFunction(name XmlName, fn LazyFunction) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.LazyFunctions[name] = fn
+	}
+}
+
+// WithXPathFunction binds a function whose body is a XPath query.  name is a
+// qualified name, like "acme:total", and the arguments are bound to the
+// variables named by params while the body is evaluated.  The name, and the
+// prefixes in the body, are resolved with the namespaces bound before this
+// setting, and the body is compiled once for each set of namespaces it is
+// applied with.  If the body is not a valid XPath query, the name or a prefix
+// cannot be resolved, or the body does not compile, queries with this setting
+// fail with the error.
+func WithXPathFunction(name string, params []string, body string) func(c *ContextSettings) {
+	xpath, syntaxErr := BuildExpr(body)
+	var lock sync.Mutex
+	compiled := make(map[string]*XPathFunction)
+
+	return func(c *ContextSettings) {
+		if syntaxErr != nil {
+			c.Fail(fmt.Errorf("xpath function %s: %w", name, syntaxErr))
+			return
+		}
+
+		qname, err := exec.GetQName(name, c.NamespaceDecls)
+
+		if err != nil {
+			c.Fail(fmt.Errorf("xpath function %s: %w", name, err))
+			return
+		}
+
+		key := namespacesKey(c.NamespaceDecls)
+		lock.Lock()
+		fn, ok := compiled[key]
+
+		if !ok {
+			fn, err = exec.CompileFunction(params, &xpath, func(s *ContextSettings) {
+				for prefix, url := range c.NamespaceDecls {
+					s.NamespaceDecls[prefix] = url
+				}
+			})
+
+			if err == nil {
+				compiled[key] = fn
+			}
+		}
+
+		lock.Unlock()
+
+		if err != nil {
+			c.Fail(fmt.Errorf("xpath function %s: %w", name, err))
+			return
+		}
+
+		c.FunctionSignatures[qname] = fn.Signature()
+		c.FunctionLibrary[qname] = fn.Function()
+	}
+}
+
+// namespacesKey identifies a set of namespace bindings, regardless of the
+// order they are iterated in.
+func namespacesKey(namespaces map[string]string) string {
+	decls := make([]string, 0, len(namespaces))
+
+	for prefix, url := range namespaces {
+		decls = append(decls, prefix+"\x00"+url)
+	}
+
+	sort.Strings(decls)
+	return strings.Join(decls, "\x00")
+}
+
+// WithVariableResolver asks the resolver for the variables that are not bound
+// to a XPath query.  Each variable is resolved at most once per query.
+func WithVariableResolver(resolver VariableResolver) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.VariableResolver = resolver
+	}
+}
+
+// WithFunctionResolver asks the resolver for the functions that are neither
+// bound to a XPath query nor built-in.  Each function is resolved at most once
+// per query.
+func WithFunctionResolver(resolver FunctionResolver) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.FunctionResolver = resolver
+	}
+}
+
+// WithLibrary binds every function in the library to a XPath query, along
+// with the library's prefix, unless the prefix is already bound.
+func WithLibrary(lib *FunctionLibrary) func(c *ContextSettings) {
+	return lib.Apply
+}
+
+// StringsLibrary returns a library of string functions that XPath 1.0 lacks,
+// under the "str" prefix.
+func StringsLibrary() *FunctionLibrary {
+	return exec.StringsLibrary()
+}
+
+// MathLibrary returns a library of numeric functions that XPath 1.0 lacks,
+// under the "math" prefix.
+func MathLibrary() *FunctionLibrary {
+	return exec.MathLibrary()
+}
+
+// ListFunctions lists the functions available to a XPath query with the given
+// settings.
+func ListFunctions(settings ...ContextApply) []FunctionInfo {
+	return exec.ListFunctions(settings...)
+}
+
+// WithIDAttributes makes the id() function treat the given attributes as
+// IDs, in addition to xml:id.
+func WithIDAttributes(names ...XmlName) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.IDAttributes = append(c.IDAttributes, names...)
+	}
+}
+
+// WithDTDIDs sets whether the id() function treats the attributes declared
+// with the ID type in the document's DTD as IDs.  It is enabled by default.
+func WithDTDIDs(enabled bool) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.DTDIDs = enabled
+	}
+}
+
+// WithDocumentRoot makes absolute paths in a XPath query select from root,
+// rather than from the node the query is executed against.  Use it to
+// evaluate a query relative to a node inside a document.
+func WithDocumentRoot(root Cursor) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.DocumentRoot = root
+	}
+}
+
+// WithContextPosition sets what position() and last() return for the node a
+// XPath query is executed against.  position is one-based.
+func WithContextPosition(position, size int) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.Position = position
+		c.Size = size
+	}
+}
+
+// WithMaxNodesVisited limits the number of nodes a XPath query may select
+// along its axes.  Queries that exceed it fail with a *LimitError.
+func WithMaxNodesVisited(max int) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.MaxNodesVisited = max
+	}
+}
+
+// WithMaxDepth limits how deeply expressions may be nested while a XPath
+// query is evaluated.  Queries that exceed it fail with a *LimitError.
+func WithMaxDepth(max int) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.MaxDepth = max
+	}
+}
+
+// WithMaxRecursion limits how deeply functions bound with WithXPathFunction
+// may call each other.  Queries that exceed it fail with a *LimitError.  It
+// defaults to 100.
+func WithMaxRecursion(max int) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.MaxRecursion = max
+	}
+}
+
+// WithMaxNodeSetSize limits the size of every NodeSet and Sequence produced
+// while a XPath query is evaluated.  Queries that exceed it fail with a
+// *LimitError.
+func WithMaxNodeSetSize(max int) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.MaxNodeSetSize = max
+	}
+}
+
+// WithTracer notifies the Tracer as each part of a XPath query is evaluated.
+func WithTracer(tracer Tracer) func(c *ContextSettings) {
+	return func(c *ContextSettings) {
+		c.Tracer = tracer
+	}
+}
+
+// NewProfiler creates a Tracer that gathers per-step statistics for XPath
+// queries.
+func NewProfiler() *Profiler {
+	return exec.NewProfiler()
+}
+
 func GetQName(input string, namespaces map[string]string) (XmlName, error) {
 	return exec.GetQName(input, namespaces)
 }
@@ -95,6 +357,74 @@ func MustBuildExpr(xpath string) Grammar {
 	return grammar.MustBuild(xpath)
 }
 
+// Compile creates an XPath query and compiles it into an expression tree that
+// can be executed any number of times with ExecExpr.  Namespace bindings are
+// resolved from the given settings when the query is compiled.
+func Compile(xpath string, settings ...ContextApply) (*Expr, error) {
+	grammar, err := grammar.Build(xpath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return exec.Compile(&grammar, settings...)
+}
+
+// Check reports the mistakes in a XPath query that would otherwise only
+// surface when it is executed: unknown functions, built-in functions called
+// with the wrong number of arguments, unbound variables and unknown namespace
+// prefixes.  If the query cannot be parsed, the SyntaxError is returned.
+func Check(xpath string, settings ...ContextApply) []error {
+	grammar, err := grammar.Build(xpath)
+
+	if err != nil {
+		return []error{err}
+	}
+
+	return exec.Check(&grammar, settings...)
+}
+
+// MustCompile is like Compile, but panics if an error is thrown.
+func MustCompile(xpath string, settings ...ContextApply) *Expr {
+	expr, err := Compile(xpath, settings...)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return expr
+}
+
+// Explain returns a readable description of how a compiled XPath query will
+// be evaluated, including the xsel extensions to XPath 1.0 it relies on.
+func Explain(expr *Expr) string {
+	return exec.Explain(expr)
+}
+
+// Prepare creates an XPath query and compiles it, along with the settings it
+// runs with, into a Query.  A Query is immutable, and can be run any number of
+// times, from any number of goroutines, with Query.Run.
+func Prepare(xpath string, settings ...ContextApply) (*Query, error) {
+	grammar, err := grammar.Build(xpath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return exec.Prepare(&grammar, settings...)
+}
+
+// MustPrepare is like Prepare, but panics if an error is thrown.
+func MustPrepare(xpath string, settings ...ContextApply) *Query {
+	query, err := Prepare(xpath, settings...)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return query
+}
+
 // ReadXml parses the given XML document and stores the node in memory.
 func ReadXml(in io.Reader, opts ...XmlParseOptions) (Cursor, error) {
 	parser := parser.ReadXml(in, opts...)
@@ -123,9 +453,42 @@ func Exec(cursor Cursor, expr *Grammar, settings ...ContextApply) (Result, error
 	return exec.Exec(cursor, expr, settings...)
 }
 
-// Like Exec, except it returns the string result of the query.
+// ExecContext is like Exec, except the query stops with the context's error
+// as soon as ctx is cancelled or its deadline passes.
+func ExecContext(ctx context.Context, cursor Cursor, expr *Grammar, settings ...ContextApply) (Result, error) {
+	return exec.ExecContext(ctx, cursor, expr, settings...)
+}
+
+// ExecExpr executes a compiled XPath query against the given Cursor and returns the result.
+func ExecExpr(cursor Cursor, expr *Expr, settings ...ContextApply) (Result, error) {
+	return exec.ExecExpr(cursor, expr, settings...)
+}
+
+// ExecExprContext is like ExecExpr, except the query stops with the context's
+// error as soon as ctx is cancelled or its deadline passes.
+func ExecExprContext(ctx context.Context, cursor Cursor, expr *Expr, settings ...ContextApply) (Result, error) {
+	return exec.ExecExprContext(ctx, cursor, expr, settings...)
+}
+
+// Iterate executes a compiled XPath query against the given Cursor, and calls
+// fn with each node it selects until fn returns false.  Nodes are selected as
+// fn asks for them, so stopping early skips the rest of the query's work.  The
+// query must return a NodeSet.
+func Iterate(cursor Cursor, expr *Expr, fn func(Cursor) bool, settings ...ContextApply) error {
+	return exec.Iterate(cursor, expr, fn, settings...)
+}
+
+// IterateContext is like Iterate, except the query stops with the context's
+// error as soon as ctx is cancelled or its deadline passes.
+func IterateContext(ctx context.Context, cursor Cursor, expr *Expr, fn func(Cursor) bool, settings ...ContextApply) error {
+	return exec.IterateContext(ctx, cursor, expr, fn, settings...)
+}
+
+// Like Exec, except it returns the string result of the query.  If the query
+// returns a NodeSet, it stops as soon as the first node is found.  If it
+// returns a Sequence, the result is the string value of its first item.
 func ExecAsString(cursor Cursor, expr *Grammar, settings ...ContextApply) (string, error) {
-	ret, err := exec.Exec(cursor, expr, settings...)
+	ret, err := exec.ExecFirst(cursor, expr, settings...)
 	if err != nil {
 		return "", err
 	}
@@ -133,9 +496,11 @@ func ExecAsString(cursor Cursor, expr *Grammar, settings ...ContextApply) (strin
 	return ret.String(), nil
 }
 
-// Like Exec, except it returns the query as a number.
+// Like Exec, except it returns the query as a number.  If the query returns a
+// NodeSet, it stops as soon as the first node is found.  If it returns a
+// Sequence, the result is the number value of its first item.
 func ExecAsNumber(cursor Cursor, expr *Grammar, settings ...ContextApply) (float64, error) {
-	ret, err := exec.Exec(cursor, expr, settings...)
+	ret, err := exec.ExecFirst(cursor, expr, settings...)
 	if err != nil {
 		return 0, err
 	}
@@ -158,6 +523,23 @@ func ExecAsNodeset(cursor Cursor, expr *Grammar, settings ...ContextApply) (Node
 	return nodeset, nil
 }
 
+// Like Exec, except it returns the items of the query as a Sequence.  Each
+// node the query selects is an item, as a NodeSet of one node.
+func ExecAsSequence(cursor Cursor, expr *Grammar, settings ...ContextApply) (Sequence, error) {
+	ret, err := exec.Exec(cursor, expr, settings...)
+	if err != nil {
+		return nil, err
+	}
+
+	return exec.Items(ret), nil
+}
+
+// Items returns the items of a Result, in order.  Each node of a NodeSet is
+// an item, as a NodeSet of one node, and any other Result is a single item.
+func Items(result Result) Sequence {
+	return exec.Items(result)
+}
+
 // GetCursorString is a convenience method to return the string value of
 // an individual Node.
 func GetCursorString(c Cursor) string {
@@ -165,8 +547,9 @@ func GetCursorString(c Cursor) string {
 }
 
 // Unmarshal maps a XPath result to a struct or slice.
-// When unmarshaling a slice, the result must be a NodeSet. When unmarshaling
-// a struct, the result must be a NodeSet with one result. To unmarshal a
+// When unmarshaling a slice, each of the result's Items is an element, so a
+// NodeSet gives one element per node, and a Sequence one per item. When
+// unmarshaling a struct, the result must be a NodeSet with one result. To unmarshal a
 // value to a struct field, give it a "xsel" tag name, and a XPath expression
 // for its value (e.g. `xsel:"//my-struct[@my-id = 'my-value']"`).
 //
@@ -3,6 +3,7 @@ package main
 import (
 	"bytes"
 	"encoding/xml"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -46,7 +47,7 @@ var VALID_FILE_TYPES = map[string]bool{
 }
 
 var concurrent = flag.Int("c", 1, "Run queries in the given number of concurrent workers (beware that results will have no predictable order)")
-var printAllNodes = flag.Bool("a", false, "If the result is a NodeSet, print the string value of all the nodes instead of just the first")
+var printAllNodes = flag.Bool("a", false, "If the result is a NodeSet or Sequence, print the string value of all the items instead of just the first")
 var suppressFileNames = flag.Bool("n", false, "Suppress filenames")
 var recursive = flag.Bool("r", false, "Recursively traverse directories")
 var asXml = flag.Bool("m", false, "If the result is a NodeSet, print all the results as XML")
@@ -55,8 +56,7 @@ var fileType = flag.String("t", "", "Force xsel to parse files as the given type
 var entities = make(keyValuePair)
 var namespaces = make(keyValuePair)
 var fileSync = sync.WaitGroup{}
-var xpath xsel.Grammar
-var variableBindings = make(map[xsel.XmlName]xsel.Result)
+var query *xsel.Query
 var semaphore chan struct{}
 
 func main() {
@@ -93,15 +93,12 @@ func main() {
 		return
 	}
 
-	xpathTest, err := xsel.BuildExpr(*xpathExpr)
+	settings := make([]xsel.ContextApply, 0)
 
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Bad XPath expression:", err)
-		return
+	for prefix, url := range namespaces {
+		settings = append(settings, xsel.WithNS(prefix, url))
 	}
 
-	xpath = xpathTest
-
 	for name, value := range variableDeclarations {
 		qName, err := xsel.GetQName(name, namespaces)
 
@@ -110,7 +107,22 @@ func main() {
 			return
 		}
 
-		variableBindings[qName] = xsel.String(value)
+		settings = append(settings, xsel.WithVariableName(qName, xsel.String(value)))
+	}
+
+	var err error
+	query, err = xsel.Prepare(*xpathExpr, settings...)
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Bad XPath expression:", err)
+
+		syntaxErr := &xsel.SyntaxError{}
+
+		if errors.As(err, &syntaxErr) {
+			fmt.Fprintln(os.Stderr, syntaxErr.Snippet())
+		}
+
+		return
 	}
 
 	for _, file := range args {
@@ -245,7 +257,7 @@ func createCursor(in io.Reader, parseType string) (xsel.Cursor, error) {
 }
 
 func executeXpath(cursor xsel.Cursor, path string) {
-	result, err := xsel.Exec(cursor, &xpath, buildContextSettings)
+	result, err := query.Run(cursor)
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error executing XPath function on file %s: %s\n", path, err)
@@ -262,9 +274,9 @@ func executeXpath(cursor xsel.Cursor, path string) {
 
 	if isNodeSet && *asXml {
 		writeXmlResult(&buffer, path, nodeSet)
-	} else if isNodeSet && *printAllNodes {
-		for _, node := range nodeSet {
-			writeResult(&buffer, path, xsel.NodeSet{node})
+	} else if *printAllNodes {
+		for _, item := range xsel.Items(result) {
+			writeResult(&buffer, path, item)
 		}
 	} else {
 		writeResult(&buffer, path, result)
@@ -273,11 +285,6 @@ func executeXpath(cursor xsel.Cursor, path string) {
 	fmt.Print(buffer.String())
 }
 
-func buildContextSettings(c *xsel.ContextSettings) {
-	c.NamespaceDecls = namespaces
-	c.Variables = variableBindings
-}
-
 func writeResult(buffer *bytes.Buffer, path string, result xsel.Result) {
 	if *suppressFileNames || path == "-" {
 		fmt.Fprintf(buffer, "%s\n", result.String())